@@ -0,0 +1,22 @@
+data "spectrocloud_cluster" "app" {
+  name = "app-cluster"
+}
+
+# Scheduled backups of the cluster's backup_policy taken in October 2026
+data "spectrocloud_cluster_backups" "daily" {
+  cluster_uid    = data.spectrocloud_cluster.app.id
+  prefix         = "daily"
+  created_after  = "2026-10-01T00:00:00Z"
+  created_before = "2026-11-01T00:00:00Z"
+}
+
+locals {
+  # Backups are listed newest first
+  latest_successful_backup = [
+    for b in data.spectrocloud_cluster_backups.daily.backups : b if b.state == "Completed"
+  ][0]
+}
+
+output "latest_successful_backup" {
+  value = local.latest_successful_backup.name
+}
@@ -0,0 +1,42 @@
+data "spectrocloud_cluster" "app" {
+  name = "app-cluster"
+}
+
+data "spectrocloud_cluster_kubeconfig" "app" {
+  cluster_uid = data.spectrocloud_cluster.app.id
+  variant     = "admin"
+}
+
+provider "kubernetes" {
+  host                   = data.spectrocloud_cluster_kubeconfig.app.host
+  cluster_ca_certificate = data.spectrocloud_cluster_kubeconfig.app.cluster_ca_certificate
+  client_certificate     = data.spectrocloud_cluster_kubeconfig.app.client_certificate
+  client_key             = data.spectrocloud_cluster_kubeconfig.app.client_key
+}
+
+# OIDC users get their token from a credential plugin instead
+data "spectrocloud_cluster_kubeconfig" "oidc" {
+  cluster_uid = data.spectrocloud_cluster.app.id
+  variant     = "oidc"
+}
+
+provider "helm" {
+  kubernetes {
+    host                   = data.spectrocloud_cluster_kubeconfig.oidc.host
+    cluster_ca_certificate = data.spectrocloud_cluster_kubeconfig.oidc.cluster_ca_certificate
+
+    dynamic "exec" {
+      for_each = data.spectrocloud_cluster_kubeconfig.oidc.exec
+      content {
+        api_version = exec.value.api_version
+        command     = exec.value.command
+        args        = exec.value.args
+        env         = exec.value.env
+      }
+    }
+  }
+}
+
+output "client_certificate_expiry" {
+  value = data.spectrocloud_cluster_kubeconfig.app.client_certificate_expiry
+}
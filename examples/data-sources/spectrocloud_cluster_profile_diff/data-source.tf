@@ -0,0 +1,37 @@
+data "spectrocloud_cluster_profile" "current" {
+  name    = "example-cluster-profile"
+  version = "1.0.0"
+}
+
+data "spectrocloud_cluster_profile" "next" {
+  name    = "example-cluster-profile"
+  version = "1.1.0"
+}
+
+# Compare two versions of a cluster profile
+data "spectrocloud_cluster_profile_diff" "upgrade" {
+  from_profile_id = data.spectrocloud_cluster_profile.current.id
+  to_profile_id   = data.spectrocloud_cluster_profile.next.id
+}
+
+# Compare a profile version with what a cluster currently runs
+data "spectrocloud_cluster" "prod" {
+  name = "prod-cluster"
+}
+
+data "spectrocloud_cluster_profile_diff" "prod_upgrade" {
+  cluster_id    = data.spectrocloud_cluster.prod.id
+  to_profile_id = data.spectrocloud_cluster_profile.next.id
+}
+
+# Fail the plan when the upgrade removes packs
+check "no_removed_packs" {
+  assert {
+    condition     = length(data.spectrocloud_cluster_profile_diff.prod_upgrade.removed_packs) == 0
+    error_message = "The profile upgrade removes packs: ${join(", ", data.spectrocloud_cluster_profile_diff.prod_upgrade.removed_packs[*].name)}"
+  }
+}
+
+output "values_diff" {
+  value = data.spectrocloud_cluster_profile_diff.prod_upgrade.values_diff
+}
@@ -0,0 +1,20 @@
+data "spectrocloud_cluster_profile" "dev" {
+  name    = "example-cluster-profile"
+  version = "1.0.0"
+}
+
+# Export the profile in Palette's import file format
+data "spectrocloud_cluster_profile_export" "dev" {
+  profile_id = data.spectrocloud_cluster_profile.dev.id
+}
+
+resource "local_file" "profile_export" {
+  filename = "${path.module}/example-cluster-profile-1.0.0.json"
+  content  = data.spectrocloud_cluster_profile_export.dev.content
+}
+
+# Import it in another tenant through a second provider configuration
+resource "spectrocloud_cluster_profile_import" "prod" {
+  provider    = spectrocloud.prod
+  import_file = local_file.profile_export.filename
+}
@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    spectrocloud = {
+      version = ">= 0.1"
+      source  = "spectrocloud/spectrocloud"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+}
+
+variable "sc_host" {
+  description = "Spectro Cloud Endpoint"
+  default     = "api.spectrocloud.com"
+}
+
+variable "sc_api_key" {
+  description = "Spectro Cloud API key"
+}
+
+variable "sc_prod_api_key" {
+  description = "Spectro Cloud API key of the tenant to import the profile into"
+}
+
+variable "sc_project_name" {
+  description = "Spectro Cloud Project (e.g: Default)"
+  default     = "Default"
+}
+
+provider "spectrocloud" {
+  host         = var.sc_host
+  api_key      = var.sc_api_key
+  project_name = var.sc_project_name
+}
+
+provider "spectrocloud" {
+  alias        = "prod"
+  host         = var.sc_host
+  api_key      = var.sc_prod_api_key
+  project_name = var.sc_project_name
+}
@@ -0,0 +1,25 @@
+data "spectrocloud_cluster" "app" {
+  name = "app-cluster"
+}
+
+data "spectrocloud_cluster_scan_results" "app" {
+  cluster_uid = data.spectrocloud_cluster.app.id
+}
+
+variable "max_kube_bench_failures" {
+  default = 5
+}
+
+locals {
+  kube_bench = one([
+    for r in data.spectrocloud_cluster_scan_results.app.reports : r if r.type == "configuration"
+  ])
+}
+
+# Fail the pipeline when the latest configuration scan has more failures than allowed
+check "kube_bench_failures" {
+  assert {
+    condition     = local.kube_bench == null || local.kube_bench.fail <= var.max_kube_bench_failures
+    error_message = "kube-bench reports ${local.kube_bench.fail} failed checks: ${join(", ", local.kube_bench.failed_checks)}"
+  }
+}
@@ -0,0 +1,24 @@
+data "spectrocloud_cluster" "app" {
+  name = "app-cluster"
+}
+
+data "spectrocloud_backup_storage_location" "bsl" {
+  name = "backups-s3"
+}
+
+# Back up the application namespaces before upgrading the cluster
+resource "spectrocloud_cluster_backup" "pre_upgrade" {
+  cluster_uid        = data.spectrocloud_cluster.app.id
+  name               = "pre-upgrade-1-30"
+  backup_location_id = data.spectrocloud_backup_storage_location.bsl.id
+  namespaces         = ["app", "ingress"]
+  include_disks      = true
+  expiry_in_hour     = 168
+
+  # Keep the backup when the resource is destroyed
+  skip_destroy = true
+
+  timeouts {
+    create = "90m"
+  }
+}
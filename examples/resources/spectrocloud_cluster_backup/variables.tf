@@ -0,0 +1,3 @@
+variable "sc_host" {}
+variable "sc_api_key" {}
+variable "sc_project_name" {}
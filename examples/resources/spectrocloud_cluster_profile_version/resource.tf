@@ -0,0 +1,27 @@
+# Create version 1.1.0 of an existing profile from its latest version,
+# upgrading Kubernetes and keeping the three newest versions.
+resource "spectrocloud_cluster_profile_version" "infra" {
+  name    = "example-infra-profile"
+  version = "1.1.0"
+
+  pack_override {
+    name = "kubernetes"
+    tag  = "1.28.2"
+  }
+
+  pack_override {
+    name   = "cni-calico"
+    values = file("${path.module}/config/calico-values.yaml")
+  }
+
+  retain_versions  = 3
+  retention_action = "deprecate"
+}
+
+# Clone a specific version instead of the latest one
+resource "spectrocloud_cluster_profile_version" "hotfix" {
+  name         = "example-infra-profile"
+  version      = "1.0.1"
+  base_version = "1.0.0"
+  skip_destroy = true
+}
@@ -0,0 +1,28 @@
+data "spectrocloud_cluster" "app" {
+  name = "app-cluster"
+}
+
+data "spectrocloud_cluster" "dr" {
+  name = "app-cluster-dr"
+}
+
+resource "spectrocloud_cluster_backup" "nightly" {
+  cluster_uid        = data.spectrocloud_cluster.app.id
+  name               = "app-dr"
+  backup_location_id = var.backup_location_id
+  namespaces         = ["app"]
+}
+
+# Restore the backup into the disaster recovery cluster
+resource "spectrocloud_cluster_restore" "dr" {
+  cluster_uid             = spectrocloud_cluster_backup.nightly.cluster_uid
+  backup_name             = spectrocloud_cluster_backup.nightly.name
+  backup_request_uid      = spectrocloud_cluster_backup.nightly.id
+  destination_cluster_uid = data.spectrocloud_cluster.dr.id
+  namespaces              = ["app"]
+  restore_volumes         = true
+
+  timeouts {
+    create = "90m"
+  }
+}
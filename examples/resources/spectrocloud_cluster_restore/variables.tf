@@ -0,0 +1,4 @@
+variable "sc_host" {}
+variable "sc_api_key" {}
+variable "sc_project_name" {}
+variable "backup_location_id" {}
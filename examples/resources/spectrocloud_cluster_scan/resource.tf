@@ -0,0 +1,20 @@
+data "spectrocloud_cluster" "app" {
+  name = "app-cluster"
+}
+
+# Run a configuration and a penetration scan after each upgrade of the cluster
+resource "spectrocloud_cluster_scan" "post_upgrade" {
+  cluster_uid        = data.spectrocloud_cluster.app.id
+  configuration_scan = true
+  penetration_scan   = true
+
+  triggers = {
+    kubernetes_version = "1.30.4"
+  }
+}
+
+output "kube_bench_failed_checks" {
+  value = one([
+    for r in spectrocloud_cluster_scan.post_upgrade.reports : r.failed_checks if r.type == "configuration"
+  ])
+}
@@ -86,6 +86,11 @@ func flattenAddonDeployment(c *client.V1Client, d *schema.ResourceData, profile
 		if err != nil {
 			return diag.FromErr(err), false
 		}
+		configPacks := getClusterProfilePacksFromConfig(d, profile.UID)
+		for _, pack := range packs {
+			pack := pack.(map[string]interface{})
+			alignPackValuesWithOverrides(pack, findConfigPackByName(configPacks, pack["name"].(string)), true)
+		}
 		cluster_profile["pack"] = packs
 	}
 
@@ -20,7 +20,7 @@ var resourceApplicationCreatePendingStates = []string{
 	"Application:Peding",
 }
 
-func waitForApplication(ctx context.Context, d *schema.ResourceData, diags diag.Diagnostics, c *client.V1Client, state string) (diag.Diagnostics, bool) {
+func waitForApplication(ctx context.Context, d *schema.ResourceData, diags diag.Diagnostics, c *client.V1Client, state string, m interface{}) (diag.Diagnostics, bool) {
 	application, err := c.GetApplication(d.Id())
 	if err != nil {
 		return diags, true
@@ -31,13 +31,12 @@ func waitForApplication(ctx context.Context, d *schema.ResourceData, diags diag.
 	}
 
 	stateConf := &retry.StateChangeConf{
-		Pending:    resourceApplicationCreatePendingStates,
-		Target:     []string{"True"},
-		Refresh:    resourceApplicationStateRefreshFunc(c, d, 5, 60),
-		Timeout:    d.Timeout(state) - 1*time.Minute,
-		MinTimeout: 10 * time.Second,
-		Delay:      resolveWaitDelay(30 * time.Second),
+		Pending: resourceApplicationCreatePendingStates,
+		Target:  []string{"True"},
+		Refresh: resourceApplicationStateRefreshFunc(c, d, 5, 60),
+		Timeout: d.Timeout(state) - 1*time.Minute,
 	}
+	waitSettingsFor(m, d).apply(stateConf)
 
 	// Wait, catching any errors
 	_, err = stateConf.WaitForStateContext(ctx)
@@ -47,12 +46,12 @@ func waitForApplication(ctx context.Context, d *schema.ResourceData, diags diag.
 	return nil, false
 }
 
-func waitForApplicationCreation(ctx context.Context, d *schema.ResourceData, diags diag.Diagnostics, c *client.V1Client) (diag.Diagnostics, bool) {
-	return waitForApplication(ctx, d, diags, c, schema.TimeoutCreate)
+func waitForApplicationCreation(ctx context.Context, d *schema.ResourceData, diags diag.Diagnostics, c *client.V1Client, m interface{}) (diag.Diagnostics, bool) {
+	return waitForApplication(ctx, d, diags, c, schema.TimeoutCreate, m)
 }
 
-func waitForApplicationUpdate(ctx context.Context, d *schema.ResourceData, diags diag.Diagnostics, c *client.V1Client) (diag.Diagnostics, bool) {
-	return waitForApplication(ctx, d, diags, c, schema.TimeoutUpdate)
+func waitForApplicationUpdate(ctx context.Context, d *schema.ResourceData, diags diag.Diagnostics, c *client.V1Client, m interface{}) (diag.Diagnostics, bool) {
+	return waitForApplication(ctx, d, diags, c, schema.TimeoutUpdate, m)
 }
 
 func resourceApplicationStateRefreshFunc(c *client.V1Client, d *schema.ResourceData, retryAttempts int, duration int) retry.StateRefreshFunc {
@@ -89,6 +88,10 @@ func resourceApplicationStateRefreshFunc(c *client.V1Client, d *schema.ResourceD
 }
 
 func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	configList := d.Get("config")
 	c := getV1ClientWithResourceContext(m, "")
 	if configList.([]interface{})[0] != nil {
@@ -97,7 +100,7 @@ func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, m in
 		c = getV1ClientWithResourceContext(m, resourceContext)
 	}
 	var diags diag.Diagnostics
-	err := c.DeleteApplication(d.Id())
+	err = c.DeleteApplication(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
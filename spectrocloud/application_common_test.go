@@ -19,7 +19,7 @@ func TestWaitForApplicationUpdate_SkipApps(t *testing.T) {
 	d.SetId("test-app-id")
 	c := getV1ClientWithResourceContext(unitTestMockAPIClient, "project")
 
-	diags, isError := waitForApplicationUpdate(context.Background(), d, diag.Diagnostics{}, c)
+	diags, isError := waitForApplicationUpdate(context.Background(), d, diag.Diagnostics{}, c, nil)
 	// The skip_apps branch returns (diags, true) without triggering the
 	// state-change waiter. We assert we returned quickly and the diags
 	// are empty (no error propagated from the early return).
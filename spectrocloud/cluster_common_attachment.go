@@ -21,7 +21,7 @@ var resourceAddonDeploymentCreatePendingStates = []string{
 	"Profile:NotAttached",
 }
 
-func waitForAddonDeployment(ctx context.Context, d *schema.ResourceData, cl models.V1SpectroCluster, profile_uid string, diags diag.Diagnostics, c *client.V1Client, state string) (diag.Diagnostics, bool) {
+func waitForAddonDeployment(ctx context.Context, d *schema.ResourceData, cl models.V1SpectroCluster, profile_uid string, diags diag.Diagnostics, c *client.V1Client, state string, m interface{}) (diag.Diagnostics, bool) {
 	cluster, err := c.GetCluster(cl.Metadata.UID)
 	if err != nil {
 		return diags, true
@@ -32,13 +32,12 @@ func waitForAddonDeployment(ctx context.Context, d *schema.ResourceData, cl mode
 	}
 
 	stateConf := &retry.StateChangeConf{
-		Pending:    resourceAddonDeploymentCreatePendingStates,
-		Target:     []string{"True"},
-		Refresh:    resourceAddonDeploymentStateRefreshFunc(c, *cluster, profile_uid),
-		Timeout:    d.Timeout(state) - 1*time.Minute,
-		MinTimeout: 10 * time.Second,
-		Delay:      resolveWaitDelay(30 * time.Second),
+		Pending: resourceAddonDeploymentCreatePendingStates,
+		Target:  []string{"True"},
+		Refresh: resourceAddonDeploymentStateRefreshFunc(c, *cluster, profile_uid),
+		Timeout: d.Timeout(state) - 1*time.Minute,
 	}
+	waitSettingsFor(m, d).apply(stateConf)
 
 	// Wait, catching any errors
 	_, err = stateConf.WaitForStateContext(ctx)
@@ -48,12 +47,12 @@ func waitForAddonDeployment(ctx context.Context, d *schema.ResourceData, cl mode
 	return nil, false
 }
 
-func waitForAddonDeploymentCreation(ctx context.Context, d *schema.ResourceData, cluster models.V1SpectroCluster, profile_uid string, diags diag.Diagnostics, c *client.V1Client) (diag.Diagnostics, bool) {
-	return waitForAddonDeployment(ctx, d, cluster, profile_uid, diags, c, schema.TimeoutCreate)
+func waitForAddonDeploymentCreation(ctx context.Context, d *schema.ResourceData, cluster models.V1SpectroCluster, profile_uid string, diags diag.Diagnostics, c *client.V1Client, m interface{}) (diag.Diagnostics, bool) {
+	return waitForAddonDeployment(ctx, d, cluster, profile_uid, diags, c, schema.TimeoutCreate, m)
 }
 
-func waitForAddonDeploymentUpdate(ctx context.Context, d *schema.ResourceData, cluster models.V1SpectroCluster, profile_uid string, diags diag.Diagnostics, c *client.V1Client) (diag.Diagnostics, bool) {
-	return waitForAddonDeployment(ctx, d, cluster, profile_uid, diags, c, schema.TimeoutUpdate)
+func waitForAddonDeploymentUpdate(ctx context.Context, d *schema.ResourceData, cluster models.V1SpectroCluster, profile_uid string, diags diag.Diagnostics, c *client.V1Client, m interface{}) (diag.Diagnostics, bool) {
+	return waitForAddonDeployment(ctx, d, cluster, profile_uid, diags, c, schema.TimeoutUpdate, m)
 }
 
 func resourceAddonDeploymentStateRefreshFunc(c *client.V1Client, cluster models.V1SpectroCluster, profile_uid string) retry.StateRefreshFunc {
@@ -102,11 +101,15 @@ func resourceAddonDeploymentStateRefreshFunc(c *client.V1Client, cluster models.
 }
 
 func resourceAddonDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if addonDeploymentResourceDisabled() {
+	if addonDeploymentResourceDisabled(m) {
 		return diag.FromErr(addonDeploymentResourceDisabledError())
 	}
 
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
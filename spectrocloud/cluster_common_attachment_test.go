@@ -93,7 +93,7 @@ func TestWaitForAddonDeployment_ContextCancelled(t *testing.T) {
 		Metadata: &models.V1ObjectMeta{UID: "cluster-uid-addon-ready"},
 	}
 
-	diags, isError := waitForAddonDeployment(ctx, d, cluster, addonProfileUID, nil, c, schema.TimeoutCreate)
+	diags, isError := waitForAddonDeployment(ctx, d, cluster, addonProfileUID, nil, c, schema.TimeoutCreate, nil)
 	assert.True(t, isError)
 	assert.NotEmpty(t, diags)
 }
@@ -113,7 +113,7 @@ func TestWaitForAddonDeploymentCreation_TargetReached(t *testing.T) {
 		Metadata: &models.V1ObjectMeta{UID: "cluster-uid-addon-ready"},
 	}
 
-	diags, isError := waitForAddonDeploymentCreation(context.Background(), d, cluster, addonProfileUID, nil, c)
+	diags, isError := waitForAddonDeploymentCreation(context.Background(), d, cluster, addonProfileUID, nil, c, nil)
 	assert.False(t, isError, "packs-ready fixture must satisfy Target=True on first Refresh")
 	assert.Empty(t, diags)
 }
@@ -128,7 +128,7 @@ func TestWaitForAddonDeploymentUpdate_TargetReached(t *testing.T) {
 		Metadata: &models.V1ObjectMeta{UID: "cluster-uid-addon-ready"},
 	}
 
-	diags, isError := waitForAddonDeploymentUpdate(context.Background(), d, cluster, addonProfileUID, nil, c)
+	diags, isError := waitForAddonDeploymentUpdate(context.Background(), d, cluster, addonProfileUID, nil, c, nil)
 	assert.False(t, isError)
 	assert.Empty(t, diags)
 }
@@ -149,11 +149,11 @@ func TestWaitForAddonDeployment_WrappersInvokeWait(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 
-	diags, isError := waitForAddonDeploymentCreation(ctx, d, cluster, addonProfileUID, nil, c)
+	diags, isError := waitForAddonDeploymentCreation(ctx, d, cluster, addonProfileUID, nil, c, nil)
 	assert.True(t, isError, "Creation wrapper must forward the cancelled-context error")
 	assert.NotEmpty(t, diags)
 
-	diags, isError = waitForAddonDeploymentUpdate(ctx, d, cluster, addonProfileUID, nil, c)
+	diags, isError = waitForAddonDeploymentUpdate(ctx, d, cluster, addonProfileUID, nil, c, nil)
 	assert.True(t, isError, "Update wrapper must forward the cancelled-context error")
 	assert.NotEmpty(t, diags)
 
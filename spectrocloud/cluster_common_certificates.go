@@ -0,0 +1,234 @@
+package spectrocloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/palette-sdk-go/client"
+)
+
+// clusterCertificatesRenewed is the state
+// resourceClusterCertificatesRenewalRefreshFunc reports once the renewal is
+// complete.
+const clusterCertificatesRenewed = "Certificates:Renewed"
+
+// readK8sCertificates sets k8s_certificates and k8s_certificates_expiry.
+// Clusters whose certificates Palette can't report, such as clusters that
+// are still provisioning, keep the values they have.
+func readK8sCertificates(c *client.V1Client, d *schema.ResourceData) diag.Diagnostics {
+	certs, err := c.GetTheKubernetesCerts(d.Id())
+	if err != nil {
+		log.Printf("[DEBUG] Cluster (%s) Kubernetes certificates not read: %v", d.Id(), err)
+		return nil
+	}
+	flat, expiry := flattenK8sCertificates(certs)
+	if err := d.Set("k8s_certificates", flat); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("k8s_certificates_expiry", formatV1Time(models.V1Time(expiry))); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// flattenK8sCertificates flattens the certificates of certs, sorted by
+// machine, certificate authority and name, and returns when the first of
+// them expires. Certificate authorities only count through their
+// certificates, as renewals don't renew them.
+func flattenK8sCertificates(certs *models.V1MachineCertificates) ([]interface{}, time.Time) {
+	type certificate struct {
+		machine, authority, name string
+		authorityExpiry, expiry  time.Time
+	}
+	var found []certificate
+	var first time.Time
+	if certs != nil {
+		for _, machine := range certs.MachineCertificates {
+			if machine == nil {
+				continue
+			}
+			for _, ca := range machine.CertificateAuthorities {
+				if ca == nil {
+					continue
+				}
+				for _, cert := range ca.Certificates {
+					if cert == nil {
+						continue
+					}
+					expiry := time.Time(cert.Expiry)
+					found = append(found, certificate{machine.Name, ca.Name, cert.Name, time.Time(ca.Expiry), expiry})
+					if !expiry.IsZero() && (first.IsZero() || expiry.Before(first)) {
+						first = expiry
+					}
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		a, b := found[i], found[j]
+		if a.machine != b.machine {
+			return a.machine < b.machine
+		}
+		if a.authority != b.authority {
+			return a.authority < b.authority
+		}
+		return a.name < b.name
+	})
+	out := make([]interface{}, 0, len(found))
+	for _, cert := range found {
+		out = append(out, map[string]interface{}{
+			"machine":                      cert.machine,
+			"certificate_authority":        cert.authority,
+			"certificate_authority_expiry": formatV1Time(models.V1Time(cert.authorityExpiry)),
+			"name":                         cert.name,
+			"expiry":                       formatV1Time(models.V1Time(cert.expiry)),
+		})
+	}
+	return out, first
+}
+
+// k8sCertificatesRenewalDue reports whether a certificate expiring at
+// expiry, in RFC3339, is due for renewal with auto_renew_certificates_before_days
+// set to days.
+func k8sCertificatesRenewalDue(expiry string, days int, now time.Time) bool {
+	if days <= 0 || expiry == "" {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, expiry)
+	if err != nil {
+		return false
+	}
+	return t.Sub(now) < time.Duration(days)*24*time.Hour
+}
+
+// planK8sCertificatesRenewal plans a renewal of the certificates of an
+// existing cluster when auto_renew_certificates_before_days says they are
+// due, by marking the certificate attributes as changing.
+func planK8sCertificatesRenewal(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	expiry, _ := d.GetChange("k8s_certificates_expiry")
+	days := d.Get("auto_renew_certificates_before_days").(int)
+	if !k8sCertificatesRenewalDue(expiry.(string), days, time.Now()) {
+		return nil
+	}
+	log.Printf("Cluster (%s) Kubernetes certificates expire at %s, within %d days: planning a renewal", d.Id(), expiry, days)
+	if err := d.SetNewComputed("k8s_certificates_expiry"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("k8s_certificates")
+}
+
+// resourceClusterCustomizeDiff is the CustomizeDiff of the cluster resources.
+func resourceClusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if err := validateClusterProfileVariables(ctx, d, m); err != nil {
+		return err
+	}
+	return planK8sCertificatesRenewal(ctx, d, m)
+}
+
+// renewK8sCertificates renews the control plane certificates of the cluster
+// when renew_k8s_certificates_now changes or planK8sCertificatesRenewal
+// planned a renewal, and waits until they are renewed and the cluster is
+// healthy again.
+func renewK8sCertificates(ctx context.Context, c *client.V1Client, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	previous, _ := d.GetChange("k8s_certificates_expiry")
+	due := k8sCertificatesRenewalDue(previous.(string), d.Get("auto_renew_certificates_before_days").(int), time.Now())
+	if !d.HasChange("renew_k8s_certificates_now") && !due {
+		return nil
+	}
+
+	// Renewed certificates are told apart by their expiry, so take it from
+	// Palette before renewing; state is empty for a cluster whose
+	// certificates were never read.
+	var before time.Time
+	if certs, err := c.GetTheKubernetesCerts(d.Id()); err == nil {
+		_, before = flattenK8sCertificates(certs)
+	}
+	if before.IsZero() && previous.(string) != "" {
+		before, _ = time.Parse(time.RFC3339, previous.(string))
+	}
+
+	log.Printf("Renewing Kubernetes certificates of cluster (%s)", d.Id())
+	if err := c.RenewClusterK8Certificates(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+	if before.IsZero() {
+		// Without an expiry to compare, the renewal only shows through the
+		// cluster leaving Running-Healthy, as for other updates.
+		started, err := waitForClusterUpdateStart(ctx, c, d, m)
+		if err != nil {
+			return diag.Diagnostics{clusterFailureDiagnostic(c, d.Id(), diag.Error, "Kubernetes certificate renewal did not complete", err.Error())}
+		}
+		if !started {
+			log.Printf("[DEBUG] Cluster (%s) reports no Kubernetes certificates and stayed Running-Healthy after the renewal", d.Id())
+			return nil
+		}
+	}
+	stateConf := &retry.StateChangeConf{
+		Pending: append([]string{"Renewing"}, resourceClusterUpdatePendingStates...),
+		Target:  []string{clusterCertificatesRenewed},
+		Refresh: resourceClusterCertificatesRenewalRefreshFunc(c, d.Id(), before),
+		Timeout: time.Until(deadline),
+	}
+	waitSettingsFor(m, d).apply(stateConf)
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Diagnostics{clusterFailureDiagnostic(c, d.Id(), diag.Error, "Kubernetes certificate renewal did not complete", err.Error())}
+	}
+	return nil
+}
+
+// resourceClusterCertificatesRenewalRefreshFunc reports
+// clusterCertificatesRenewed once the first certificate of the cluster
+// expires after before and the cluster is Running-Healthy, and the
+// cluster's state until then. A zero before only waits for Running-Healthy.
+// A failed certificate condition of the cluster is an error.
+func resourceClusterCertificatesRenewalRefreshFunc(c *client.V1Client, id string, before time.Time) retry.StateRefreshFunc {
+	refresh := resourceClusterStateRefreshFunc(c, id)
+	return func() (interface{}, string, error) {
+		result, state, err := refresh()
+		if err != nil || result == nil {
+			return result, state, err
+		}
+		cluster := result.(*models.V1SpectroCluster)
+
+		if cluster.Status != nil {
+			for _, cond := range cluster.Status.Conditions {
+				if cond == nil || cond.Type == nil || cond.Status == nil {
+					continue
+				}
+				if strings.Contains(strings.ToLower(*cond.Type), "certificate") && *cond.Status == "False" {
+					return cluster, state, fmt.Errorf("%s: %s", *cond.Type, cond.Message)
+				}
+			}
+		}
+		if state != "Running-Healthy" {
+			return cluster, state, nil
+		}
+
+		if !before.IsZero() {
+			certs, err := c.GetTheKubernetesCerts(id)
+			if err != nil {
+				return nil, "", err
+			}
+			if _, first := flattenK8sCertificates(certs); !first.After(before) {
+				log.Printf("Cluster (%s) Kubernetes certificates not renewed yet", id)
+				return cluster, "Renewing", nil
+			}
+		}
+		return cluster, clusterCertificatesRenewed, nil
+	}
+}
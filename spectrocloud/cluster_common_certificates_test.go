@@ -0,0 +1,167 @@
+package spectrocloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+)
+
+func TestFlattenK8sCertificates(t *testing.T) {
+	expiry := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	certs := &models.V1MachineCertificates{
+		MachineCertificates: []*models.V1MachineCertificate{
+			{
+				Name: "cp-1",
+				CertificateAuthorities: []*models.V1CertificateAuthority{
+					{
+						Name:         "ca",
+						Expiry:       models.V1Time(expiry.AddDate(9, 0, 0)),
+						Certificates: []*models.V1Certificate{{Name: "apiserver", Expiry: models.V1Time(expiry.Add(time.Hour))}},
+					},
+				},
+			},
+			{
+				Name: "cp-0",
+				CertificateAuthorities: []*models.V1CertificateAuthority{
+					{
+						Name:         "etcd-ca",
+						Expiry:       models.V1Time(expiry.AddDate(-1, 0, 0)),
+						Certificates: []*models.V1Certificate{{Name: "etcd-server", Expiry: models.V1Time(expiry)}},
+					},
+					nil,
+				},
+			},
+		},
+	}
+
+	flat, first := flattenK8sCertificates(certs)
+	assert.Equal(t, expiry, first, "certificate authorities don't count")
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"machine":                      "cp-0",
+			"certificate_authority":        "etcd-ca",
+			"certificate_authority_expiry": "2025-11-01T00:00:00Z",
+			"name":                         "etcd-server",
+			"expiry":                       "2026-11-01T00:00:00Z",
+		},
+		map[string]interface{}{
+			"machine":                      "cp-1",
+			"certificate_authority":        "ca",
+			"certificate_authority_expiry": "2035-11-01T00:00:00Z",
+			"name":                         "apiserver",
+			"expiry":                       "2026-11-01T01:00:00Z",
+		},
+	}, flat)
+
+	flat, first = flattenK8sCertificates(nil)
+	assert.Empty(t, flat)
+	assert.True(t, first.IsZero())
+}
+
+func TestK8sCertificatesRenewalDue(t *testing.T) {
+	now := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	assert.True(t, k8sCertificatesRenewalDue("2026-11-01T00:00:00Z", 30, now))
+	assert.False(t, k8sCertificatesRenewalDue("2026-11-01T00:00:00Z", 10, now))
+	assert.False(t, k8sCertificatesRenewalDue("2026-11-01T00:00:00Z", 0, now), "0 never renews")
+	assert.False(t, k8sCertificatesRenewalDue("", 30, now), "certificates not read yet")
+	assert.False(t, k8sCertificatesRenewalDue("not a time", 30, now))
+}
+
+func TestPlanK8sCertificatesRenewal(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"auto_renew_certificates_before_days": schemas.AutoRenewCertificatesBeforeDaysSchema(),
+			"k8s_certificates_expiry":             schemas.K8sCertificatesExpirySchema(),
+			"k8s_certificates":                    schemas.K8sCertificatesSchema(),
+		},
+		CustomizeDiff: planK8sCertificatesRenewal,
+	}
+	diff := func(expiresIn time.Duration, days int) *terraform.InstanceDiff {
+		state := &terraform.InstanceState{
+			ID: "test-cluster-id",
+			Attributes: map[string]string{
+				"auto_renew_certificates_before_days": "0",
+				"k8s_certificates_expiry":             time.Now().Add(expiresIn).UTC().Format(time.RFC3339),
+			},
+		}
+		cfg := terraform.NewResourceConfigRaw(map[string]interface{}{"auto_renew_certificates_before_days": days})
+		d, err := r.Diff(context.Background(), state, cfg, nil)
+		require.NoError(t, err)
+		return d
+	}
+
+	d := diff(10*24*time.Hour, 30)
+	require.NotNil(t, d)
+	assert.True(t, d.Attributes["k8s_certificates_expiry"].NewComputed, "certificates expiring within 30 days are renewed")
+	assert.Nil(t, diff(60*24*time.Hour, 30).Attributes["k8s_certificates_expiry"])
+	assert.Nil(t, diff(10*24*time.Hour, 0).Attributes["k8s_certificates_expiry"])
+}
+
+// clusterCertificatesData returns the data of an update of cluster uid, whose
+// certificates expire at expiry, that sets renew_k8s_certificates_now to
+// renewNow when it isn't empty.
+func clusterCertificatesData(t *testing.T, uid, expiry, renewNow string) *schema.ResourceData {
+	t.Helper()
+	state := &terraform.InstanceState{
+		ID:         uid,
+		Attributes: map[string]string{"context": "project", "k8s_certificates_expiry": expiry},
+	}
+	diff := &terraform.InstanceDiff{Attributes: map[string]*terraform.ResourceAttrDiff{}}
+	if renewNow != "" {
+		diff.Attributes["renew_k8s_certificates_now"] = &terraform.ResourceAttrDiff{New: renewNow}
+	}
+	d, err := schema.InternalMap(resourceClusterAws().Schema).Data(state, diff)
+	require.NoError(t, err)
+	return d
+}
+
+func TestRenewK8sCertificates(t *testing.T) {
+	d := clusterCertificatesData(t, "cluster-uid-certs", "2026-11-01T00:00:00Z", "")
+	assert.Empty(t, renewK8sCertificates(context.Background(), mustUnitClient(t, true), d, nil), "nothing to renew")
+
+	d = clusterCertificatesData(t, "cluster-uid-certs", "2026-11-01T00:00:00Z", "2026-10-17T00:00:00Z")
+	require.Empty(t, renewK8sCertificates(context.Background(), mustUnitClient(t, false), d, nil))
+	require.Empty(t, readK8sCertificates(mustUnitClient(t, false), d))
+	assert.Equal(t, "2027-11-01T00:00:00Z", d.Get("k8s_certificates_expiry"))
+	assert.Len(t, d.Get("k8s_certificates"), 6)
+
+	d = clusterCertificatesData(t, "cluster-uid-certs-failed", "2026-11-01T00:00:00Z", "2026-10-17T00:00:00Z")
+	diags := renewK8sCertificates(context.Background(), mustUnitClient(t, false), d, nil)
+	assertFirstDiagMessage(t, diags, "Kubernetes certificate renewal did not complete")
+	assert.Contains(t, diags[0].Detail, "CertificatesRenewed: kubeadm certs renew failed on cp-0")
+}
+
+func TestRenewK8sCertificatesNeverRead(t *testing.T) {
+	meta := *unitTestMockAPIClient.(*providerMeta)
+	meta.wait.pollInterval = 10 * time.Millisecond
+	c := mustUnitClient(t, false)
+
+	t.Run("expiry taken from Palette", func(t *testing.T) {
+		d := clusterCertificatesData(t, "cluster-uid-certs-unread", "", "2026-10-17T00:00:00Z")
+		require.Empty(t, renewK8sCertificates(context.Background(), c, d, &meta))
+		require.Empty(t, readK8sCertificates(c, d))
+		assert.Equal(t, "2027-11-01T00:00:00Z", d.Get("k8s_certificates_expiry"), "the wait ends once the expiry moved")
+	})
+
+	t.Run("no certificates reported", func(t *testing.T) {
+		defer func(timeout time.Duration) { clusterUpdateStartTimeout = timeout }(clusterUpdateStartTimeout)
+		clusterUpdateStartTimeout = 50 * time.Millisecond
+
+		d := clusterCertificatesData(t, "cluster-uid-running", "", "2026-10-17T00:00:00Z")
+		assert.Empty(t, renewK8sCertificates(context.Background(), c, d, &meta), "a cluster that stays Running-Healthy had nothing to renew")
+	})
+}
+
+func TestReadK8sCertificatesNotReported(t *testing.T) {
+	d := clusterCertificatesData(t, "test-cluster-id", "2026-11-01T00:00:00Z", "")
+	require.Empty(t, readK8sCertificates(mustUnitClient(t, false), d))
+	assert.Equal(t, "2026-11-01T00:00:00Z", d.Get("k8s_certificates_expiry"), "kept when Palette reports no certificates")
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -39,38 +40,36 @@ var virtualClusterLifecycleStates = []string{
 	"Paused",
 }
 
-func waitForClusterReady(ctx context.Context, d *schema.ResourceData, uid string, diags diag.Diagnostics, c *client.V1Client) (diag.Diagnostics, bool) {
+func waitForClusterReady(ctx context.Context, d *schema.ResourceData, uid string, diags diag.Diagnostics, c *client.V1Client, m interface{}) (diag.Diagnostics, bool) {
 	d.SetId(uid)
 
 	stateConf := &retry.StateChangeConf{
-		Pending:    resourceClusterReadyPendingStates,
-		Target:     []string{"Ready"},
-		Refresh:    resourceClusterReadyRefreshFunc(c, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutCreate) - 1*time.Minute,
-		MinTimeout: 10 * time.Second,
-		Delay:      resolveWaitDelay(30 * time.Second),
+		Pending: resourceClusterReadyPendingStates,
+		Target:  []string{"Ready"},
+		Refresh: resourceClusterReadyRefreshFunc(c, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate) - 1*time.Minute,
 	}
+	waitSettingsFor(m, d).apply(stateConf)
 
 	// Wait, catching any errors
 	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return diag.FromErr(err), true
+		return diag.Diagnostics{clusterFailureDiagnostic(c, d.Id(), diag.Error, "Cluster did not become ready", err.Error())}, true
 	}
 	return nil, false
 }
 
-func waitForVirtualClusterLifecyclePause(ctx context.Context, d *schema.ResourceData, uid string, diags diag.Diagnostics, c *client.V1Client) (diag.Diagnostics, bool) {
+func waitForVirtualClusterLifecyclePause(ctx context.Context, d *schema.ResourceData, uid string, diags diag.Diagnostics, c *client.V1Client, m interface{}) (diag.Diagnostics, bool) {
 	clusterContext := d.Get("context").(string)
 
 	d.SetId(uid)
 	stateConf := &retry.StateChangeConf{
-		Pending:    virtualClusterLifecycleStates,
-		Target:     []string{"Paused"},
-		Refresh:    resourceVirtualClusterLifecycleStateRefreshFunc(c, clusterContext, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutCreate) - 1*time.Minute,
-		MinTimeout: 10 * time.Second,
-		Delay:      resolveWaitDelay(30 * time.Second),
+		Pending: virtualClusterLifecycleStates,
+		Target:  []string{"Paused"},
+		Refresh: resourceVirtualClusterLifecycleStateRefreshFunc(c, clusterContext, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate) - 1*time.Minute,
 	}
+	waitSettingsFor(m, d).apply(stateConf)
 
 	// Wait, catching any errors
 	_, err := stateConf.WaitForStateContext(ctx)
@@ -79,18 +78,17 @@ func waitForVirtualClusterLifecyclePause(ctx context.Context, d *schema.Resource
 	}
 	return nil, false
 }
-func waitForVirtualClusterLifecycleResume(ctx context.Context, d *schema.ResourceData, uid string, diags diag.Diagnostics, c *client.V1Client) (diag.Diagnostics, bool) {
+func waitForVirtualClusterLifecycleResume(ctx context.Context, d *schema.ResourceData, uid string, diags diag.Diagnostics, c *client.V1Client, m interface{}) (diag.Diagnostics, bool) {
 	clusterContext := d.Get("context").(string)
 
 	d.SetId(uid)
 	stateConf := &retry.StateChangeConf{
-		Pending:    virtualClusterLifecycleStates,
-		Target:     []string{"Running"},
-		Refresh:    resourceVirtualClusterLifecycleStateRefreshFunc(c, clusterContext, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutCreate) - 1*time.Minute,
-		MinTimeout: 10 * time.Second,
-		Delay:      resolveWaitDelay(30 * time.Second),
+		Pending: virtualClusterLifecycleStates,
+		Target:  []string{"Running"},
+		Refresh: resourceVirtualClusterLifecycleStateRefreshFunc(c, clusterContext, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate) - 1*time.Minute,
 	}
+	waitSettingsFor(m, d).apply(stateConf)
 
 	// Wait, catching any errors
 	_, err := stateConf.WaitForStateContext(ctx)
@@ -112,7 +110,7 @@ func resourceClusterReadyRefreshFunc(c *client.V1Client, id string) retry.StateR
 	}
 }
 
-func waitForClusterCreation(ctx context.Context, d *schema.ResourceData, uid string, diags diag.Diagnostics, c *client.V1Client, initial bool) (diag.Diagnostics, bool) {
+func waitForClusterCreation(ctx context.Context, d *schema.ResourceData, uid string, diags diag.Diagnostics, c *client.V1Client, initial bool, m interface{}) (diag.Diagnostics, bool) {
 	d.SetId(uid)
 
 	if initial { // only skip_completion when initially creating a cluster, do not skip when attach addon profile
@@ -125,19 +123,20 @@ func waitForClusterCreation(ctx context.Context, d *schema.ResourceData, uid str
 		}
 	}
 
-	diagnostics, isError := waitForClusterReady(ctx, d, uid, diags, c)
+	diagnostics, isError := waitForClusterReady(ctx, d, uid, diags, c, m)
 	if isError {
 		return diagnostics, true
 	}
 
+	waitFor := clusterWaitForFrom(d)
+	var unmet []string
 	stateConf := &retry.StateChangeConf{
-		Pending:    resourceClusterCreatePendingStates,
-		Target:     []string{"Running-Healthy"},
-		Refresh:    resourceClusterStateRefreshFunc(c, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutCreate) - 1*time.Minute,
-		MinTimeout: 10 * time.Second,
-		Delay:      resolveWaitDelay(30 * time.Second),
+		Pending: resourceClusterWaitForPendingStates,
+		Target:  []string{clusterWaitForMet},
+		Refresh: resourceClusterWaitForRefreshFunc(ctx, c, d.Id(), waitFor, &unmet),
+		Timeout: d.Timeout(schema.TimeoutCreate) - 1*time.Minute,
 	}
+	waitSettingsFor(m, d).apply(stateConf)
 
 	// Wait, catching any errors
 	_, err := stateConf.WaitForStateContext(ctx)
@@ -164,38 +163,131 @@ func waitForClusterCreation(ctx context.Context, d *schema.ResourceData, uid str
 				}
 			}
 
-			// Always return warning instead of error for timeout
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  "Cluster creation timeout",
-				Detail: fmt.Sprintf(
+			detail := fmt.Sprintf(
+				"Cluster creation timed out after waiting for %v. Current cluster state is '%s'. "+
+					"The cluster may still be provisioning in the background and could eventually reach the 'Running-Healthy' state.",
+				d.Timeout(schema.TimeoutCreate)-1*time.Minute, currentState)
+			if !waitFor.isDefault() {
+				detail = fmt.Sprintf(
 					"Cluster creation timed out after waiting for %v. Current cluster state is '%s'. "+
-						"The cluster may still be provisioning in the background and could eventually reach the 'Running-Healthy' state.",
-					d.Timeout(schema.TimeoutCreate)-1*time.Minute, currentState),
-			})
+						"The cluster may still be provisioning in the background and could eventually meet its wait_for conditions. "+
+						"Conditions not met: %s.",
+					d.Timeout(schema.TimeoutCreate)-1*time.Minute, currentState, strings.Join(unmet, "; "))
+			}
+
+			// Always return warning instead of error for timeout
+			diags = append(diags, clusterFailureDiagnostic(c, d.Id(), diag.Warning, "Cluster creation timeout", detail))
 			return diags, false
 		}
 		// For non-timeout errors, still return the error
-		return diag.FromErr(err), true
+		return diag.Diagnostics{clusterFailureDiagnostic(c, d.Id(), diag.Error, "Cluster creation failed", err.Error())}, true
 	}
 	return nil, false
 }
 
-//	var resourceClusterUpdatePendingStates = []string{
-//		"backing-up",
-//		"modifying",
-//		"resetting-master-credentials",
-//		"upgrading",
-//	}
-func waitForClusterDeletion(ctx context.Context, c *client.V1Client, scope, id string, timeout time.Duration) error {
+var resourceClusterUpdatePendingStates = []string{
+	"Pending",
+	"Provisioning",
+	"Running",
+	"Upgrading",
+	"Modifying",
+	"Importing",
+}
+
+// clusterUpdateStarted is the state clusterUpdateStartedRefreshFunc
+// reports once the cluster has left Running-Healthy.
+const clusterUpdateStarted = "Update:Started"
+
+// clusterUpdateStartTimeout bounds how long waitForClusterUpdate waits for
+// Palette to start applying an update. Changes that need no reconciliation,
+// such as tags, never take the cluster out of Running-Healthy.
+var clusterUpdateStartTimeout = 2 * time.Minute
+
+// waitForClusterUpdate waits, when the resource sets wait_on_update, for the
+// cluster to finish applying an update and be Running-Healthy again.
+// Right after the update the cluster can still report Running-Healthy, so
+// it first waits for the cluster to leave it. Paused virtual clusters are
+// not waited for.
+func waitForClusterUpdate(ctx context.Context, d *schema.ResourceData, c *client.V1Client, m interface{}) diag.Diagnostics {
+	if wait, _ := d.Get("wait_on_update").(bool); !wait {
+		return nil
+	}
+	if paused, _ := d.Get("pause_cluster").(bool); paused {
+		return nil
+	}
+
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+	started, err := waitForClusterUpdateStart(ctx, c, d, m)
+	if err != nil {
+		return diag.Diagnostics{clusterFailureDiagnostic(c, d.Id(), diag.Error, "Cluster update did not complete", err.Error())}
+	}
+	if !started {
+		log.Printf("[DEBUG] Cluster (%s) stayed Running-Healthy after the update: nothing to wait for", d.Id())
+		return nil
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: resourceClusterUpdatePendingStates,
+		Target:  []string{"Running-Healthy"},
+		Refresh: resourceClusterStateRefreshFunc(c, d.Id()),
+		Timeout: time.Until(deadline),
+	}
+	waitSettingsFor(m, d).apply(stateConf)
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Diagnostics{clusterFailureDiagnostic(c, d.Id(), diag.Error, "Cluster update did not complete", err.Error())}
+	}
+	return nil
+}
+
+// waitForClusterUpdateStart waits, for at most clusterUpdateStartTimeout,
+// until a change takes the cluster out of Running-Healthy. It returns false
+// when the cluster stayed Running-Healthy: the change had nothing to roll
+// out.
+func waitForClusterUpdateStart(ctx context.Context, c *client.V1Client, d *schema.ResourceData, m interface{}) (bool, error) {
+	timeout := clusterUpdateStartTimeout
+	if t := d.Timeout(schema.TimeoutUpdate); t < timeout {
+		timeout = t
+	}
+	started := &retry.StateChangeConf{
+		Pending: []string{"Running-Healthy"},
+		Target:  []string{clusterUpdateStarted},
+		Refresh: clusterUpdateStartedRefreshFunc(c, d.Id()),
+		Timeout: timeout,
+	}
+	waitSettingsFor(m, d).apply(started)
+	started.Delay = 0
+	if _, err := started.WaitForStateContext(ctx); err != nil {
+		var timeoutErr *retry.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// clusterUpdateStartedRefreshFunc reports clusterUpdateStarted once the
+// cluster is in any state other than Running-Healthy.
+func clusterUpdateStartedRefreshFunc(c *client.V1Client, id string) retry.StateRefreshFunc {
+	refresh := resourceClusterStateRefreshFunc(c, id)
+	return func() (interface{}, string, error) {
+		result, state, err := refresh()
+		if err != nil || result == nil || state == "Running-Healthy" {
+			return result, state, err
+		}
+		return result, clusterUpdateStarted, nil
+	}
+}
+
+func waitForClusterDeletion(ctx context.Context, c *client.V1Client, scope, id string, timeout time.Duration, wait waitSettings) error {
 	stateConf := &retry.StateChangeConf{
-		Pending:    resourceClusterDeletePendingStates,
-		Target:     nil, // wait for deleted
-		Refresh:    resourceClusterStateRefreshFunc(c, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      resolveWaitDelay(30 * time.Second),
+		Pending: resourceClusterDeletePendingStates,
+		Target:  nil, // wait for deleted
+		Refresh: resourceClusterStateRefreshFunc(c, id),
+		Timeout: timeout,
 	}
+	wait.apply(stateConf)
 
 	_, err := stateConf.WaitForStateContext(ctx)
 
@@ -259,9 +351,12 @@ func resourceClusterRead(d *schema.ResourceData, c *client.V1Client, diags diag.
 
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 	var diags diag.Diagnostics
-	var err error
 	clusterContext := d.Get("context").(string)
 	if forceDelete, ok := d.GetOk("force_delete"); ok && forceDelete == true {
 		forceDeleteDelay := d.Get("force_delete_delay").(int)
@@ -271,7 +366,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interf
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			err = waitForClusterDeletion(ctx, c, clusterContext, d.Id(), forceDeleteDelaDuration) // It will wait for 20 minutes by default and try force_delete
+			err = waitForClusterDeletion(ctx, c, clusterContext, d.Id(), forceDeleteDelaDuration, waitSettingsFor(m, d)) // It will wait for 20 minutes by default and try force_delete
 			if err != nil {
 				err = c.ForceDeleteCluster(d.Id(), true)
 				if err != nil {
@@ -292,8 +387,8 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interf
 			return diag.FromErr(err)
 		}
 	}
-	if err := waitForClusterDeletion(ctx, c, clusterContext, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return diag.FromErr(err)
+	if err := waitForClusterDeletion(ctx, c, clusterContext, d.Id(), d.Timeout(schema.TimeoutDelete), waitSettingsFor(m, d)); err != nil {
+		return diag.Diagnostics{clusterFailureDiagnostic(c, d.Id(), diag.Error, "Cluster deletion did not complete", err.Error())}
 	}
 	return diags
 }
@@ -33,13 +33,14 @@ import (
 // clusterFixtureFor(). Each test picks a UID that shapes the mock's
 // response into the exact state branch under test.
 
-// castV1Client extracts the concrete *client.V1Client from
-// unitTestMockAPIClient (typed as interface{} at the package level).
+// castV1Client extracts the concrete *client.V1Client from the
+// *providerMeta in unitTestMockAPIClient (typed as interface{} at the
+// package level).
 // A helper here avoids repeating the assertion in every t.Run.
 func castV1Client(t *testing.T, m interface{}) *client.V1Client {
-	c, ok := m.(*client.V1Client)
-	require.True(t, ok, "unitTestMockAPIClient must be a *client.V1Client")
-	return c
+	meta, ok := m.(*providerMeta)
+	require.True(t, ok, "unitTestMockAPIClient must be a *providerMeta")
+	return meta.client
 }
 
 func TestResourceClusterReadyRefreshFunc(t *testing.T) {
@@ -219,7 +220,7 @@ func TestWaitForClusterDeletion_ContextCancelled(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel() // pre-cancel
 
-	err := waitForClusterDeletion(ctx, c, "project", "test-cluster-id", 5*time.Second)
+	err := waitForClusterDeletion(ctx, c, "project", "test-cluster-id", 5*time.Second, defaultWaitSettings)
 	require.Error(t, err, "cancelled context must surface an error")
 }
 
@@ -235,7 +236,7 @@ func TestWaitForClusterReady_ContextCancelled(t *testing.T) {
 	// No need to shrink the timeout — the context is already cancelled,
 	// so WaitForStateContext returns immediately with ctx.Err().
 
-	diags, hadError := waitForClusterReady(ctx, d, "test-cluster-id", nil, c)
+	diags, hadError := waitForClusterReady(ctx, d, "test-cluster-id", nil, c, nil)
 	assert.True(t, hadError)
 	assert.NotEmpty(t, diags)
 }
@@ -249,7 +250,7 @@ func TestWaitForClusterCreation_SkipCompletion(t *testing.T) {
 	d := resourceClusterAws().TestResourceData()
 	_ = d.Set("skip_completion", true)
 
-	diags, exited := waitForClusterCreation(context.Background(), d, "test-cluster-id", nil, c, true)
+	diags, exited := waitForClusterCreation(context.Background(), d, "test-cluster-id", nil, c, true, nil)
 	assert.True(t, exited, "skip_completion=true must exit before touching the API")
 	assert.Empty(t, diags)
 }
@@ -263,7 +264,56 @@ func TestWaitForClusterCreation_ContextCancelled(t *testing.T) {
 	cancel()
 
 	d := resourceClusterAws().TestResourceData()
-	diags, hadError := waitForClusterCreation(ctx, d, "test-cluster-id", nil, c, true)
+	diags, hadError := waitForClusterCreation(ctx, d, "test-cluster-id", nil, c, true, nil)
 	assert.True(t, hadError)
 	assert.NotEmpty(t, diags)
 }
+
+func TestWaitForClusterUpdate(t *testing.T) {
+	c := castV1Client(t, unitTestMockAPIClient)
+
+	t.Run("not requested", func(t *testing.T) {
+		d := resourceClusterAws().TestResourceData()
+		d.SetId("cluster-uid-server-error")
+		assert.Empty(t, waitForClusterUpdate(context.Background(), d, c, nil), "without wait_on_update the cluster is not polled")
+	})
+
+	t.Run("running healthy", func(t *testing.T) {
+		defer func(timeout time.Duration) { clusterUpdateStartTimeout = timeout }(clusterUpdateStartTimeout)
+		clusterUpdateStartTimeout = 50 * time.Millisecond
+
+		d := resourceClusterAws().TestResourceData()
+		d.SetId("cluster-uid-running")
+		require.NoError(t, d.Set("wait_on_update", true))
+		require.NoError(t, d.Set("wait_settings", []interface{}{map[string]interface{}{"poll_interval": "10ms"}}))
+		assert.Empty(t, waitForClusterUpdate(context.Background(), d, c, nil), "a cluster that never leaves Running-Healthy had nothing to apply")
+	})
+
+	t.Run("update started", func(t *testing.T) {
+		d := resourceClusterAws().TestResourceData()
+		d.SetId("cluster-uid-upgrading-once")
+		require.NoError(t, d.Set("wait_on_update", true))
+		require.NoError(t, d.Set("wait_settings", []interface{}{map[string]interface{}{"poll_interval": "10ms"}}))
+		assert.Empty(t, waitForClusterUpdate(context.Background(), d, c, nil))
+	})
+
+	t.Run("paused virtual cluster", func(t *testing.T) {
+		d := resourceClusterVirtual().TestResourceData()
+		d.SetId("cluster-uid-server-error")
+		require.NoError(t, d.Set("wait_on_update", true))
+		require.NoError(t, d.Set("pause_cluster", true))
+		assert.Empty(t, waitForClusterUpdate(context.Background(), d, c, nil))
+	})
+
+	t.Run("failure carries the report", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		d := resourceClusterAws().TestResourceData()
+		d.SetId(clusterFailedUID)
+		require.NoError(t, d.Set("wait_on_update", true))
+		diags := waitForClusterUpdate(ctx, d, c, nil)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Cluster update did not complete", diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, "Packs not ready:")
+	})
+}
@@ -1,6 +1,7 @@
 package spectrocloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -12,7 +13,7 @@ import (
 )
 
 // read common fields like kubeconfig, tags, backup policy, scan policy, cluster_rbac_binding, namespaces
-func readCommonFields(c *client.V1Client, d *schema.ResourceData, cluster *models.V1SpectroCluster) (diag.Diagnostics, bool) {
+func readCommonFields(c *client.V1Client, d *schema.ResourceData, cluster *models.V1SpectroCluster, m interface{}) (diag.Diagnostics, bool) {
 	//ClusterContext := "project"
 	//if cluster.Metadata.Annotations["scope"] != "" {
 	//	ClusterContext = cluster.Metadata.Annotations["scope"]
@@ -145,7 +146,11 @@ func readCommonFields(c *client.V1Client, d *schema.ResourceData, cluster *model
 		}
 	}
 
-	if diags := syncClusterProfilesFromAPIWhenAddonDeploymentDisabled(c, d, cluster); diags.HasError() {
+	if diags := syncClusterProfilesFromAPIWhenAddonDeploymentDisabled(c, d, cluster, m); diags.HasError() {
+		return diags, true
+	}
+
+	if diags := readK8sCertificates(c, d); diags.HasError() {
 		return diags, true
 	}
 
@@ -165,11 +170,11 @@ func getSpectroComponentsUpgrade(cluster *models.V1SpectroCluster) string {
 	return "unlock"
 }
 
-func updateCommonFieldsForBrownfieldCluster(d *schema.ResourceData, c *client.V1Client) diag.Diagnostics {
+func updateCommonFieldsForBrownfieldCluster(d *schema.ResourceData, c *client.V1Client, m interface{}) diag.Diagnostics {
 	_ = updateClusterMetadata(c, d)
 	_ = updateClusterNamespaces(c, d)
 	_ = updateClusterRBAC(c, d)
-	_ = updateProfiles(c, d)
+	_ = updateProfiles(c, d, m)
 	if _, ok := d.GetOk("backup_policy"); ok {
 		_ = updateBackupPolicy(c, d)
 	}
@@ -185,7 +190,7 @@ func updateCommonFieldsForBrownfieldCluster(d *schema.ResourceData, c *client.V1
 }
 
 // update common fields like namespaces, cluster_rbac_binding, cluster_profile, backup_policy, scan_policy
-func updateCommonFields(d *schema.ResourceData, c *client.V1Client) (diag.Diagnostics, bool) {
+func updateCommonFields(ctx context.Context, d *schema.ResourceData, c *client.V1Client, m interface{}) (diag.Diagnostics, bool) {
 	if d.HasChanges("name", "tags", "description", "tags_map") {
 		if err := updateClusterMetadata(c, d); err != nil {
 			return diag.FromErr(err), true
@@ -219,7 +224,7 @@ func updateCommonFields(d *schema.ResourceData, c *client.V1Client) (diag.Diagno
 
 	// Handle cluster_profile changes using the existing profile update flow
 	if d.HasChanges("cluster_profile", "packs", "manifests") {
-		if err := updateProfiles(c, d); err != nil {
+		if err := updateProfiles(c, d, m); err != nil {
 			return diag.FromErr(err), true
 		}
 	}
@@ -266,13 +271,16 @@ func updateCommonFields(d *schema.ResourceData, c *client.V1Client) (diag.Diagno
 		}
 	}
 
-	if diags := renewK8sCertificatesNow(c, d); diags.HasError() {
+	if diags := renewK8sCertificates(ctx, c, d, m); diags.HasError() {
 		return diags, true
 	}
 
 	return diag.Diagnostics{}, false
 }
 
+// renewK8sCertificatesNow renews the control plane certificates when
+// renew_k8s_certificates_now changes, without waiting: a brownfield cluster
+// is not imported yet when it is created.
 func renewK8sCertificatesNow(c *client.V1Client, d *schema.ResourceData) diag.Diagnostics {
 	if !d.HasChange("renew_k8s_certificates_now") {
 		return nil
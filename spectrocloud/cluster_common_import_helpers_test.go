@@ -156,7 +156,7 @@ func TestUpdateCommonFieldsForBrownfieldCluster(t *testing.T) {
 	d.SetId("test-cluster-id")
 	_ = d.Set("context", "project")
 
-	diags := updateCommonFieldsForBrownfieldCluster(d, c)
+	diags := updateCommonFieldsForBrownfieldCluster(d, c, nil)
 	// May return an error diag if renewK8sCertificatesNow hits the SDK;
 	// no panic is the coverage-goal contract.
 	_ = diags
@@ -177,7 +177,7 @@ func TestWaitForVirtualClusterLifecyclePause(t *testing.T) {
 	// Timeouts on a raw TestResourceData default to 20m — plenty long.
 
 	diags, isErr := waitForVirtualClusterLifecyclePause(
-		context.Background(), d, "cluster-uid-paused", diag.Diagnostics{}, c)
+		context.Background(), d, "cluster-uid-paused", diag.Diagnostics{}, c, nil)
 	assert.False(t, isErr)
 	assert.Empty(t, diags)
 }
@@ -196,7 +196,7 @@ func TestWaitForVirtualClusterLifecycleResume(t *testing.T) {
 	defer cancel()
 
 	_, _ = waitForVirtualClusterLifecycleResume(ctx, d,
-		"cluster-uid-vcluster-running", diag.Diagnostics{}, c)
+		"cluster-uid-vcluster-running", diag.Diagnostics{}, c, nil)
 }
 
 // Compile-time reference to keep the schema import used (for the
@@ -12,6 +12,7 @@ import (
 	"github.com/spectrocloud/palette-sdk-go/api/models"
 	"github.com/spectrocloud/palette-sdk-go/client"
 
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 	"github.com/spectrocloud/terraform-provider-spectrocloud/types"
 )
 
@@ -242,8 +243,12 @@ func toProfilesCommon(c *client.V1Client, d *schema.ResourceData, clusterUID, co
 			// Pack values only exist in cluster_profile, not in cluster_template
 			if source == "cluster_profile" {
 				if packs, ok := p["pack"]; ok && packs != nil {
+					profileValues := clusterProfilePackValues(c, p["id"].(string))
 					for _, pack := range p["pack"].([]interface{}) {
 						p := toPack(cluster, pack)
+						if err := setPackValuesOverrides(p, pack.(map[string]interface{}), profileValues); err != nil {
+							return nil, err
+						}
 						packValues = append(packValues, p)
 					}
 				}
@@ -294,6 +299,23 @@ func setPackValues(pack *models.V1PackValuesEntity, p map[string]interface{}) {
 	}
 }
 
+// setPackValuesOverrides merges the values_overrides of p into the pack
+// values. Without values they apply to the values the cluster profile sets
+// for the pack.
+func setPackValuesOverrides(pack *models.V1PackValuesEntity, p map[string]interface{}, profileValues func(packName string) (string, error)) error {
+	if len(schemas.PackValuesOverrides(p)) == 0 {
+		return nil
+	}
+	values, err := packValuesWithOverrides(p, func() (string, error) {
+		return profileValues(*pack.Name)
+	})
+	if err != nil {
+		return err
+	}
+	pack.Values = values
+	return nil
+}
+
 func setPackTag(pack *models.V1PackValuesEntity, p map[string]interface{}) {
 	if val, found := p["tag"]; found && len(val.(string)) > 0 {
 		pack.Tag = val.(string)
@@ -572,14 +594,14 @@ func computeProfilesToDelete(c *client.V1Client, oldProfiles, newProfiles []inte
 	return profilesToDelete
 }
 
-func updateProfiles(c *client.V1Client, d *schema.ResourceData) error {
+func updateProfiles(c *client.V1Client, d *schema.ResourceData, m interface{}) error {
 	log.Printf("Updating cluster_profile (not cluster_template)")
 
 	// Capture old cluster_profile to restore on error (pre-apply snapshot, or API sync when flag is on).
 	oldProfileRaw, _ := d.GetChange("cluster_profile")
 	oldProfile := normalizeInterfaceSliceFromListOrSet(oldProfileRaw)
 	rollbackProfiles := func() {
-		rollbackClusterProfileOnUpdateError(c, d, oldProfile)
+		rollbackClusterProfileOnUpdateError(c, d, oldProfile, m)
 	}
 
 	profiles, err := toAddonDeplProfiles(c, d)
@@ -644,9 +666,9 @@ func updateProfiles(c *client.V1Client, d *schema.ResourceData) error {
 	}
 
 	ctx := context.Background()
-	if err := waitForProfileDownload(ctx, c, clusterContext, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if err := waitForProfileDownload(ctx, c, clusterContext, d.Id(), d.Timeout(schema.TimeoutUpdate), waitSettingsFor(m, d)); err != nil {
 		rollbackProfiles()
-		return err
+		return withClusterFailureReport(c, d.Id(), err)
 	}
 
 	// Profile Variable Handling - only for cluster_profile
@@ -891,8 +913,8 @@ func enrichClusterProfilesWithVariables(c *client.V1Client, d *schema.ResourceDa
 	return clusterProfiles, nil
 }
 
-func shouldSyncClusterProfilesFromAPI(d *schema.ResourceData) bool {
-	if !addonDeploymentResourceDisabled() {
+func shouldSyncClusterProfilesFromAPI(d *schema.ResourceData, m interface{}) bool {
+	if !addonDeploymentResourceDisabled(m) {
 		return false
 	}
 	if raw := d.Get("cluster_template"); raw != nil {
@@ -930,8 +952,8 @@ func setClusterProfilesFromAPI(c *client.V1Client, d *schema.ResourceData, clust
 // rollbackClusterProfileOnUpdateError restores cluster_profile after a failed updateProfiles.
 // When shouldSyncClusterProfilesFromAPI is true, re-fetches the cluster and syncs from API so
 // ResourceData reflects Palette (including partial applies). Otherwise restores the pre-apply snapshot.
-func rollbackClusterProfileOnUpdateError(c *client.V1Client, d *schema.ResourceData, oldProfile []interface{}) {
-	if shouldSyncClusterProfilesFromAPI(d) && c != nil && d.Id() != "" {
+func rollbackClusterProfileOnUpdateError(c *client.V1Client, d *schema.ResourceData, oldProfile []interface{}, m interface{}) {
+	if shouldSyncClusterProfilesFromAPI(d, m) && c != nil && d.Id() != "" {
 		refreshed, err := c.GetCluster(d.Id())
 		if err != nil {
 			log.Printf("Warning: could not refresh cluster for profile rollback from API: %v; restoring pre-apply cluster_profile", err)
@@ -949,8 +971,8 @@ func rollbackClusterProfileOnUpdateError(c *client.V1Client, d *schema.ResourceD
 
 // syncClusterProfilesFromAPIWhenAddonDeploymentDisabled refreshes cluster_profile from the API during
 // read when disable_addon_deployment_resource is true (addon profiles are owned by the cluster resource).
-func syncClusterProfilesFromAPIWhenAddonDeploymentDisabled(c *client.V1Client, d *schema.ResourceData, cluster *models.V1SpectroCluster) diag.Diagnostics {
-	if !shouldSyncClusterProfilesFromAPI(d) {
+func syncClusterProfilesFromAPIWhenAddonDeploymentDisabled(c *client.V1Client, d *schema.ResourceData, cluster *models.V1SpectroCluster, m interface{}) diag.Diagnostics {
+	if !shouldSyncClusterProfilesFromAPI(d, m) {
 		return nil
 	}
 	if err := setClusterProfilesFromAPI(c, d, cluster); err != nil {
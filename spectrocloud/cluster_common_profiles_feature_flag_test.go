@@ -78,10 +78,6 @@ func copyPackMap(src map[string]interface{}) map[string]interface{} {
 }
 
 func TestShouldSyncClusterProfilesFromAPI(t *testing.T) {
-	t.Cleanup(func() {
-		disableAddonDeploymentResource = false
-	})
-
 	baseSchema := &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"cluster_profile": {
@@ -107,32 +103,29 @@ func TestShouldSyncClusterProfilesFromAPI(t *testing.T) {
 	}
 
 	t.Run("false when feature flag is off", func(t *testing.T) {
-		disableAddonDeploymentResource = false
+		m := withTestAddonDeploymentDisabled(nil, false)
 		d := baseSchema.TestResourceData()
-		assert.False(t, shouldSyncClusterProfilesFromAPI(d))
+		assert.False(t, shouldSyncClusterProfilesFromAPI(d, m))
 	})
 
 	t.Run("true when feature flag is on and cluster_template is not used", func(t *testing.T) {
-		disableAddonDeploymentResource = true
+		m := withTestAddonDeploymentDisabled(nil, true)
 		d := baseSchema.TestResourceData()
-		assert.True(t, shouldSyncClusterProfilesFromAPI(d))
+		assert.True(t, shouldSyncClusterProfilesFromAPI(d, m))
 	})
 
 	t.Run("false when feature flag is on but cluster_template is set", func(t *testing.T) {
-		disableAddonDeploymentResource = true
+		m := withTestAddonDeploymentDisabled(nil, true)
 		d := baseSchema.TestResourceData()
 		_ = d.Set("cluster_template", []interface{}{
 			map[string]interface{}{"id": "template-1"},
 		})
-		assert.False(t, shouldSyncClusterProfilesFromAPI(d))
+		assert.False(t, shouldSyncClusterProfilesFromAPI(d, m))
 	})
 }
 
 func TestSyncClusterProfilesFromAPIWhenAddonDeploymentDisabled(t *testing.T) {
-	t.Cleanup(func() {
-		disableAddonDeploymentResource = false
-	})
-	disableAddonDeploymentResource = true
+	m := withTestAddonDeploymentDisabled(unitTestMockAPIClient, true)
 
 	r := resourceClusterEks()
 	d := r.TestResourceData()
@@ -149,7 +142,7 @@ func TestSyncClusterProfilesFromAPIWhenAddonDeploymentDisabled(t *testing.T) {
 		},
 	}
 
-	diags := syncClusterProfilesFromAPIWhenAddonDeploymentDisabled(nil, d, cluster)
+	diags := syncClusterProfilesFromAPIWhenAddonDeploymentDisabled(nil, d, cluster, m)
 	require.Empty(t, diags)
 
 	profiles := normalizeInterfaceSliceFromListOrSet(d.Get("cluster_profile"))
@@ -179,24 +172,20 @@ func TestSetClusterProfilesFromAPI(t *testing.T) {
 }
 
 func TestRollbackClusterProfileOnUpdateError(t *testing.T) {
-	t.Cleanup(func() {
-		disableAddonDeploymentResource = false
-	})
-
 	r := resourceClusterEks()
 	oldProfile := []interface{}{
 		map[string]interface{}{"id": "pre-apply-profile"},
 	}
 
 	t.Run("flag off restores pre-apply snapshot", func(t *testing.T) {
-		disableAddonDeploymentResource = false
+		m := withTestAddonDeploymentDisabled(nil, false)
 		d := r.TestResourceData()
 		d.SetId("cluster-1")
 		_ = d.Set("cluster_profile", []interface{}{
 			map[string]interface{}{"id": "failed-desired-profile"},
 		})
 
-		rollbackClusterProfileOnUpdateError(nil, d, oldProfile)
+		rollbackClusterProfileOnUpdateError(nil, d, oldProfile, m)
 
 		profiles := normalizeInterfaceSliceFromListOrSet(d.Get("cluster_profile"))
 		require.Len(t, profiles, 1)
@@ -204,14 +193,14 @@ func TestRollbackClusterProfileOnUpdateError(t *testing.T) {
 	})
 
 	t.Run("flag on without client falls back to pre-apply snapshot", func(t *testing.T) {
-		disableAddonDeploymentResource = true
+		m := withTestAddonDeploymentDisabled(nil, true)
 		d := r.TestResourceData()
 		d.SetId("cluster-1")
 		_ = d.Set("cluster_profile", []interface{}{
 			map[string]interface{}{"id": "failed-desired-profile"},
 		})
 
-		rollbackClusterProfileOnUpdateError(nil, d, oldProfile)
+		rollbackClusterProfileOnUpdateError(nil, d, oldProfile, m)
 
 		profiles := normalizeInterfaceSliceFromListOrSet(d.Get("cluster_profile"))
 		require.Len(t, profiles, 1)
@@ -219,7 +208,7 @@ func TestRollbackClusterProfileOnUpdateError(t *testing.T) {
 	})
 
 	t.Run("flag on with cluster_template restores pre-apply snapshot", func(t *testing.T) {
-		disableAddonDeploymentResource = true
+		m := withTestAddonDeploymentDisabled(nil, true)
 		d := r.TestResourceData()
 		d.SetId("cluster-1")
 		_ = d.Set("cluster_template", []interface{}{
@@ -229,7 +218,7 @@ func TestRollbackClusterProfileOnUpdateError(t *testing.T) {
 			map[string]interface{}{"id": "failed-desired-profile"},
 		})
 
-		rollbackClusterProfileOnUpdateError(nil, d, oldProfile)
+		rollbackClusterProfileOnUpdateError(nil, d, oldProfile, m)
 
 		profiles := normalizeInterfaceSliceFromListOrSet(d.Get("cluster_profile"))
 		require.Len(t, profiles, 1)
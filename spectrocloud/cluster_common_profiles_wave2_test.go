@@ -121,7 +121,7 @@ func TestUpdateProfilesAddProfile_RealDiff(t *testing.T) {
 	)
 	require.True(t, d.HasChange("cluster_profile"))
 
-	require.NoError(t, updateProfiles(c, d))
+	require.NoError(t, updateProfiles(c, d, nil))
 }
 
 // TestUpdateProfilesRemovesAddonProfile_RealDiff drives the profile-deletion
@@ -142,7 +142,7 @@ func TestUpdateProfilesRemovesAddonProfile_RealDiff(t *testing.T) {
 	toDelete := getProfilesToDelete(c, d, mustGetCluster(t, c, "test-cluster-id"))
 	require.Contains(t, toDelete, "cluster-profile-import-2")
 
-	require.NoError(t, updateProfiles(c, d))
+	require.NoError(t, updateProfiles(c, d, nil))
 }
 
 // TestUpdateProfilesVariableUpdate_RealDiff drives the profile-variable
@@ -165,7 +165,7 @@ func TestUpdateProfilesVariableUpdate_RealDiff(t *testing.T) {
 	)
 	require.True(t, d.HasChange("cluster_profile"))
 
-	require.NoError(t, updateProfiles(c, d))
+	require.NoError(t, updateProfiles(c, d, nil))
 }
 
 // TestUpdateProfilesVariableUpdateError_RealDiff forces
@@ -189,7 +189,7 @@ func TestUpdateProfilesVariableUpdateError_RealDiff(t *testing.T) {
 	d.SetId(clusterVariablesPatchErrorUID)
 	require.True(t, d.HasChange("cluster_profile"))
 
-	err := updateProfiles(c, d)
+	err := updateProfiles(c, d, nil)
 	require.Error(t, err)
 
 	profiles := normalizeInterfaceSliceFromListOrSet(d.Get("cluster_profile"))
@@ -235,7 +235,7 @@ func TestUpdateProfilesRollbackOnBadContext_RealDiff(t *testing.T) {
 	require.NoError(t, err)
 	d.SetId("test-cluster-id")
 
-	updateErr := updateProfiles(c, d)
+	updateErr := updateProfiles(c, d, nil)
 	require.Error(t, updateErr)
 
 	profiles := normalizeInterfaceSliceFromListOrSet(d.Get("cluster_profile"))
@@ -262,7 +262,7 @@ func TestUpdateProfilesGetClusterError_RealDiff(t *testing.T) {
 	}))
 	d.SetId("cluster-uid-server-error")
 
-	err := updateProfiles(c, d)
+	err := updateProfiles(c, d, nil)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to get cluster for profile update")
 }
@@ -279,7 +279,7 @@ func TestUpdateProfilesSetReplaceWithProfileError_RealDiff(t *testing.T) {
 		nil,
 	)
 
-	err := updateProfiles(c, d)
+	err := updateProfiles(c, d, nil)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to resolve profile replacements")
 
@@ -424,8 +424,7 @@ func TestFlattenClusterTemplateVariables_VariablesAPIError(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestRollbackClusterProfileOnUpdateError_APISync(t *testing.T) {
-	t.Cleanup(func() { disableAddonDeploymentResource = false })
-	disableAddonDeploymentResource = true
+	m := withTestAddonDeploymentDisabled(unitTestMockAPIClient, true)
 
 	c := castV1Client(t, unitTestMockAPIClient)
 	oldProfile := []interface{}{map[string]interface{}{"id": "pre-apply-profile"}}
@@ -435,7 +434,7 @@ func TestRollbackClusterProfileOnUpdateError_APISync(t *testing.T) {
 		d.SetId("test-cluster-id")
 		_ = d.Set("cluster_profile", []interface{}{map[string]interface{}{"id": "failed-desired-profile"}})
 
-		rollbackClusterProfileOnUpdateError(c, d, oldProfile)
+		rollbackClusterProfileOnUpdateError(c, d, oldProfile, m)
 
 		profiles := normalizeInterfaceSliceFromListOrSet(d.Get("cluster_profile"))
 		require.Len(t, profiles, 2)
@@ -451,7 +450,7 @@ func TestRollbackClusterProfileOnUpdateError_APISync(t *testing.T) {
 		d.SetId("cluster-uid-server-error")
 		_ = d.Set("cluster_profile", []interface{}{map[string]interface{}{"id": "failed-desired-profile"}})
 
-		rollbackClusterProfileOnUpdateError(c, d, oldProfile)
+		rollbackClusterProfileOnUpdateError(c, d, oldProfile, m)
 
 		profiles := normalizeInterfaceSliceFromListOrSet(d.Get("cluster_profile"))
 		require.Len(t, profiles, 1)
@@ -463,7 +462,7 @@ func TestRollbackClusterProfileOnUpdateError_APISync(t *testing.T) {
 		d.SetId("cluster-uid-not-found")
 		_ = d.Set("cluster_profile", []interface{}{map[string]interface{}{"id": "failed-desired-profile"}})
 
-		rollbackClusterProfileOnUpdateError(c, d, oldProfile)
+		rollbackClusterProfileOnUpdateError(c, d, oldProfile, m)
 
 		profiles := normalizeInterfaceSliceFromListOrSet(d.Get("cluster_profile"))
 		require.Len(t, profiles, 1)
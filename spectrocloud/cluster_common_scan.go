@@ -0,0 +1,255 @@
+package spectrocloud
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+)
+
+// Scan types, named after the schedules of schemas.ScanPolicySchema, and the
+// Palette driver that runs each of them.
+const (
+	clusterScanConfiguration = "configuration"
+	clusterScanPenetration   = "penetration"
+	clusterScanConformance   = "conformance"
+)
+
+var clusterScanTypes = []string{clusterScanConfiguration, clusterScanPenetration, clusterScanConformance}
+
+const (
+	clusterScanStateCompleted = "Completed"
+	clusterScanStateFailed    = "Failed"
+)
+
+// clusterScanReportSchema describes a scan report, as listed by the
+// spectrocloud_cluster_scan_results data source and the
+// spectrocloud_cluster_scan resource.
+func clusterScanReportSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The type of the scan: `configuration` (kube-bench), `penetration` (kube-hunter) " +
+					"or `conformance` (Sonobuoy).",
+			},
+			"request_uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The request UID of the scan.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the scan, such as `InProgress`, `Completed` or `Failed`.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The message Palette reports for the state of the scan, if any.",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the scan started, in RFC3339 format.",
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the scan finished, in RFC3339 format. Empty while the scan runs.",
+			},
+			"pass": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of checks that passed. Always `0` for penetration scans.",
+			},
+			"fail": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of checks that failed. For penetration scans, the number of vulnerabilities found.",
+			},
+			"warn": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of checks that ended with a warning. Only configuration scans report warnings.",
+			},
+			"failed_checks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "The sorted IDs of the checks that failed: kube-bench test IDs such as `1.2.16` for configuration scans, " +
+					"kube-hunter vulnerability IDs such as `KHV002` for penetration scans, and test names for conformance scans.",
+			},
+		},
+	}
+}
+
+// clusterScanReport is a scan log reduced to what clusterScanReportSchema
+// describes.
+type clusterScanReport struct {
+	scanType     string
+	requestUID   string
+	state        string
+	message      string
+	start, end   time.Time
+	pass         int
+	fail         int
+	warn         int
+	failedChecks []string
+}
+
+func (r clusterScanReport) flatten() map[string]interface{} {
+	failed := r.failedChecks
+	if failed == nil {
+		failed = []string{}
+	}
+	return map[string]interface{}{
+		"type":          r.scanType,
+		"request_uid":   r.requestUID,
+		"state":         r.state,
+		"message":       r.message,
+		"start_time":    formatV1Time(models.V1Time(r.start)),
+		"end_time":      formatV1Time(models.V1Time(r.end)),
+		"pass":          r.pass,
+		"fail":          r.fail,
+		"warn":          r.warn,
+		"failed_checks": failed,
+	}
+}
+
+// latestClusterScanReports returns the latest report of each scan type found
+// in logs, keyed by scan type.
+func latestClusterScanReports(logs *models.V1ClusterComplianceScanLogs) map[string]clusterScanReport {
+	var reports []clusterScanReport
+	if logs != nil {
+		for _, l := range logs.KubeBenchLogs {
+			if l != nil && l.Status != nil {
+				reports = append(reports, kubeBenchScanReport(l))
+			}
+		}
+		for _, l := range logs.KubeHunterLogs {
+			if l != nil && l.Status != nil {
+				reports = append(reports, kubeHunterScanReport(l))
+			}
+		}
+		for _, l := range logs.SonobuoyLogs {
+			if l != nil && l.Status != nil {
+				reports = append(reports, sonobuoyScanReport(l))
+			}
+		}
+	}
+
+	latest := make(map[string]clusterScanReport, len(clusterScanTypes))
+	for _, r := range reports {
+		if prev, ok := latest[r.scanType]; !ok || r.start.After(prev.start) {
+			latest[r.scanType] = r
+		}
+	}
+	return latest
+}
+
+// flattenClusterScanReports flattens the reports of types, in the order of
+// clusterScanTypes. Types without a report are left out.
+func flattenClusterScanReports(reports map[string]clusterScanReport, types []string) []interface{} {
+	out := make([]interface{}, 0, len(types))
+	for _, t := range clusterScanTypes {
+		r, ok := reports[t]
+		if ok && slices.Contains(types, t) {
+			out = append(out, r.flatten())
+		}
+	}
+	return out
+}
+
+// clusterScanTimes returns when a scan started and finished. Scans that
+// don't report a start time fall back to when their log was created.
+func clusterScanTimes(meta *models.V1ObjectMeta, scanTime *models.V1ClusterScanTime) (start, end time.Time) {
+	if scanTime != nil {
+		start, end = time.Time(scanTime.StartTime), time.Time(scanTime.EndTime)
+	}
+	if start.IsZero() && meta != nil {
+		start = time.Time(meta.CreationTimestamp)
+	}
+	return start, end
+}
+
+func kubeBenchScanReport(l *models.V1ClusterScanLogKubeBench) clusterScanReport {
+	r := clusterScanReport{
+		scanType:   clusterScanConfiguration,
+		requestUID: l.Status.RequestUID,
+		state:      l.Status.State,
+		message:    l.Status.Message,
+	}
+	r.start, r.end = clusterScanTimes(l.Metadata, l.Status.ScanTime)
+	failed := map[string]bool{}
+	for _, report := range l.Status.Reports {
+		r.pass += int(report.Pass)
+		r.fail += int(report.Fail)
+		r.warn += int(report.Warn)
+		for _, check := range report.Logs {
+			if check != nil && strings.EqualFold(check.State, "FAIL") && check.TestID != "" {
+				failed[check.TestID] = true
+			}
+		}
+	}
+	r.failedChecks = sortedKeys(failed)
+	return r
+}
+
+func kubeHunterScanReport(l *models.V1ClusterScanLogKubeHunter) clusterScanReport {
+	r := clusterScanReport{
+		scanType:   clusterScanPenetration,
+		requestUID: l.Status.RequestUID,
+		state:      l.Status.State,
+		message:    l.Status.Message,
+	}
+	r.start, r.end = clusterScanTimes(l.Metadata, l.Status.ScanTime)
+	failed := map[string]bool{}
+	for _, report := range l.Status.Reports {
+		if v := report.Vulnerabilites; v != nil {
+			r.fail += int(v.High + v.Medium + v.Low)
+		}
+		for _, vulnerability := range report.Logs {
+			if vulnerability != nil && vulnerability.TestID != "" {
+				failed[vulnerability.TestID] = true
+			}
+		}
+	}
+	r.failedChecks = sortedKeys(failed)
+	return r
+}
+
+func sonobuoyScanReport(l *models.V1ClusterScanLogSonobuoy) clusterScanReport {
+	r := clusterScanReport{
+		scanType:   clusterScanConformance,
+		requestUID: l.Status.RequestUID,
+		state:      l.Status.State,
+		message:    l.Status.Message,
+	}
+	r.start, r.end = clusterScanTimes(l.Metadata, l.Status.ScanTime)
+	failed := map[string]bool{}
+	for _, report := range l.Status.Reports {
+		r.pass += int(report.Pass)
+		r.fail += int(report.Fail)
+		for _, test := range report.Logs {
+			if test != nil && strings.EqualFold(test.State, "failed") && test.Description != "" {
+				failed[test.Description] = true
+			}
+		}
+	}
+	r.failedChecks = sortedKeys(failed)
+	return r
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -33,7 +33,7 @@ var resourceVirtualMachineCreatePendingStates = []string{
 	"Deleted",
 }
 
-func waitForVirtualMachineToTargetState(ctx context.Context, d *schema.ResourceData, clusterUid, vmName, namespace string, diags diag.Diagnostics, c *client.V1Client, state, targetState string) (diag.Diagnostics, bool) {
+func waitForVirtualMachineToTargetState(ctx context.Context, d *schema.ResourceData, clusterUid, vmName, namespace string, diags diag.Diagnostics, c *client.V1Client, state, targetState string, m interface{}) (diag.Diagnostics, bool) {
 	vm, err := c.GetVirtualMachine(clusterUid, namespace, vmName)
 	if err != nil {
 		return diags, true
@@ -47,13 +47,12 @@ func waitForVirtualMachineToTargetState(ctx context.Context, d *schema.ResourceD
 	}
 
 	stateConf := &retry.StateChangeConf{
-		Pending:    resourceVirtualMachineCreatePendingStates,
-		Target:     []string{targetState},
-		Refresh:    resourceVirtualMachineStateRefreshFunc(c, clusterUid, vmName, namespace),
-		Timeout:    d.Timeout(state) - 1*time.Minute,
-		MinTimeout: 10 * time.Second,
-		Delay:      resolveWaitDelay(30 * time.Second),
+		Pending: resourceVirtualMachineCreatePendingStates,
+		Target:  []string{targetState},
+		Refresh: resourceVirtualMachineStateRefreshFunc(c, clusterUid, vmName, namespace),
+		Timeout: d.Timeout(state) - 1*time.Minute,
 	}
+	waitSettingsFor(m, d).apply(stateConf)
 
 	// Wait, catching any errors
 	_, err = stateConf.WaitForStateContext(ctx)
@@ -1,6 +1,7 @@
 package spectrocloud
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,8 +21,9 @@ func mustUnitClient(t *testing.T, negative bool) *client.V1Client {
 		raw = unitTestMockAPIClient
 	}
 
-	c, ok := raw.(*client.V1Client)
-	require.True(t, ok, "expected mock client to be *client.V1Client")
+	meta, ok := raw.(*providerMeta)
+	require.True(t, ok, "expected mock client to be *providerMeta")
+	c := meta.client
 	require.NotNil(t, c)
 	return c
 }
@@ -52,7 +54,7 @@ func TestUpdateProfilesSkipApply(t *testing.T) {
 		},
 	)
 
-	require.NoError(t, updateProfiles(c, d))
+	require.NoError(t, updateProfiles(c, d, nil))
 }
 
 func TestUpdateCommonFieldsClusterProfilePath(t *testing.T) {
@@ -66,7 +68,7 @@ func TestUpdateCommonFieldsClusterProfilePath(t *testing.T) {
 		[]interface{}{map[string]interface{}{"id": "cluster-profile-import-1"}},
 	)
 
-	diags, done := updateCommonFields(d, mustUnitClient(t, false))
+	diags, done := updateCommonFields(context.Background(), d, mustUnitClient(t, false), nil)
 	assert.False(t, done)
 	assert.Empty(t, diags)
 }
@@ -79,7 +81,7 @@ func TestUpdateProfilesMissingClusterID(t *testing.T) {
 		map[string]interface{}{"id": "cluster-profile-import-1"},
 	}))
 
-	err := updateProfiles(c, d)
+	err := updateProfiles(c, d, nil)
 	assert.Error(t, err)
 }
 
@@ -100,7 +102,7 @@ func TestUpdateProfilesDeletesRemovedAddonProfile(t *testing.T) {
 		},
 	)
 
-	require.NoError(t, updateProfiles(c, d))
+	require.NoError(t, updateProfiles(c, d, nil))
 }
 
 func TestEnrichClusterProfilesWithVariablesWithMock(t *testing.T) {
@@ -182,10 +184,7 @@ func TestSetReplaceWithProfileForExistingWithMock(t *testing.T) {
 }
 
 func TestReadCommonFieldsSyncProfilesWhenAddonDisabled(t *testing.T) {
-	t.Cleanup(func() {
-		disableAddonDeploymentResource = false
-	})
-	disableAddonDeploymentResource = true
+	m := withTestAddonDeploymentDisabled(unitTestMockAPIClient, true)
 
 	cluster := prepareSpectroClusterModel()
 	cluster.Spec.ClusterProfileTemplates = []*models.V1ClusterProfileTemplate{
@@ -195,7 +194,7 @@ func TestReadCommonFieldsSyncProfilesWhenAddonDisabled(t *testing.T) {
 	d := resourceClusterEks().TestResourceData()
 	d.SetId("test-cluster-id")
 
-	diags, hasError := readCommonFields(mustUnitClient(t, false), d, cluster)
+	diags, hasError := readCommonFields(mustUnitClient(t, false), d, cluster, m)
 	assert.False(t, hasError)
 	assert.Empty(t, diags)
 
@@ -217,7 +216,7 @@ func TestReadCommonFieldsPaths(t *testing.T) {
 		require.NoError(t, d.Set("cluster_meta_attribute", "placeholder"))
 		require.NoError(t, d.Set("cluster_timezone", "UTC"))
 
-		diags, hasError := readCommonFields(mustUnitClient(t, false), d, cluster)
+		diags, hasError := readCommonFields(mustUnitClient(t, false), d, cluster, nil)
 		assert.False(t, hasError)
 		assert.Empty(t, diags)
 		assert.Equal(t, "test-meta", d.Get("cluster_meta_attribute"))
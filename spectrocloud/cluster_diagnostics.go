@@ -0,0 +1,216 @@
+package spectrocloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/palette-sdk-go/client"
+)
+
+// clusterEventLimit bounds how many of a cluster's most recent events a
+// failure report includes.
+const clusterEventLimit = 5
+
+// clusterFailureReport is what Palette knows about why a cluster has not
+// reached the state a waiter expected: its state and unmet conditions, the
+// machine pools and nodes its health check flags, packs that are not ready,
+// and its most recent events. Every part is best effort; whatever cannot be
+// fetched is left out.
+type clusterFailureReport struct {
+	state      string
+	health     string
+	conditions []string
+	unhealthy  []string
+	packs      []string
+	events     []string
+}
+
+// collectClusterFailureReport gathers a clusterFailureReport for uid.
+func collectClusterFailureReport(c *client.V1Client, uid string) clusterFailureReport {
+	r := clusterFailureReport{}
+
+	if cluster, err := c.GetClusterWithoutStatus(uid); err != nil {
+		log.Printf("[DEBUG] Unable to read cluster %s for failure report: %v", uid, err)
+	} else if cluster != nil && cluster.Status != nil {
+		r.state = cluster.Status.State
+		r.conditions = unmetClusterConditions(cluster.Status.Conditions)
+		r.packs = unreadyClusterPacks(cluster.Status.Packs)
+	}
+
+	if overview, err := c.GetClusterOverview(uid); err != nil {
+		log.Printf("[DEBUG] Unable to read cluster %s overview for failure report: %v", uid, err)
+	} else if overview != nil && overview.Status != nil && overview.Status.Health != nil {
+		r.health = overview.Status.Health.State
+		for _, hc := range overview.Status.Health.Conditions {
+			if hc == nil {
+				continue
+			}
+			r.unhealthy = append(r.unhealthy, describeHealthCondition(hc))
+		}
+	}
+
+	limit := int64(50)
+	if events, err := c.GetEvents("spectrocluster", uid, nil, nil, nil, nil, &limit, nil, nil); err != nil {
+		log.Printf("[DEBUG] Unable to list cluster %s events for failure report: %v", uid, err)
+	} else {
+		r.events = recentClusterEvents(events, clusterEventLimit)
+	}
+	return r
+}
+
+// String renders the report as the detail of a Terraform diagnostic.
+func (r clusterFailureReport) String() string {
+	var b strings.Builder
+	switch {
+	case r.state != "" && r.health != "" && r.health != "Healthy":
+		fmt.Fprintf(&b, "Cluster state: %s (health: %s)\n", r.state, r.health)
+	case r.state != "":
+		fmt.Fprintf(&b, "Cluster state: %s\n", r.state)
+	}
+	writeReportSection(&b, "Unmet conditions", r.conditions)
+	writeReportSection(&b, "Unhealthy machine pools and nodes", r.unhealthy)
+	writeReportSection(&b, "Packs not ready", r.packs)
+	writeReportSection(&b, "Recent events", r.events)
+	return strings.TrimSpace(b.String())
+}
+
+func writeReportSection(b *strings.Builder, title string, lines []string) {
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n%s:\n", title)
+	for _, l := range lines {
+		fmt.Fprintf(b, "  - %s\n", l)
+	}
+}
+
+func unmetClusterConditions(conditions []*models.V1ClusterCondition) []string {
+	var out []string
+	for _, cond := range conditions {
+		if cond == nil || cond.Type == nil || (cond.Status != nil && *cond.Status == "True") {
+			continue
+		}
+		out = append(out, describeClusterCondition(*cond.Type, cond))
+	}
+	return out
+}
+
+func unreadyClusterPacks(packs []*models.V1ClusterPackStatus) []string {
+	var out []string
+	for _, p := range packs {
+		if p == nil || p.Condition == nil || (p.Condition.Status != nil && *p.Condition.Status == "True") {
+			continue
+		}
+		out = append(out, describeClusterCondition(p.Name, p.Condition))
+	}
+	return out
+}
+
+func describeClusterCondition(subject string, cond *models.V1ClusterCondition) string {
+	s := subject
+	if cond.Status != nil {
+		s += "=" + *cond.Status
+	}
+	if cond.Reason != "" {
+		s += " (" + cond.Reason + ")"
+	}
+	if cond.Message != "" {
+		s += ": " + cond.Message
+	}
+	return s
+}
+
+func describeHealthCondition(hc *models.V1SpectroClusterHealthCondition) string {
+	subject := hc.Type
+	if o := hc.RelatedObject; o != nil && o.Name != "" {
+		subject = strings.TrimSpace(o.Kind + " " + o.Name)
+	}
+	if hc.Message == "" {
+		return subject
+	}
+	if subject == "" {
+		return hc.Message
+	}
+	return subject + ": " + hc.Message
+}
+
+// recentClusterEvents returns up to limit of events, newest first. Warning
+// and error events are preferred over informational ones, which are only
+// used to fill the remaining slots.
+func recentClusterEvents(events []*models.V1Event, limit int) []string {
+	var important, normal []*models.V1Event
+	for _, e := range events {
+		if e == nil {
+			continue
+		}
+		switch strings.ToLower(e.Severity) {
+		case "warning", "error", "critical":
+			important = append(important, e)
+		default:
+			normal = append(normal, e)
+		}
+	}
+	byNewest := func(list []*models.V1Event) {
+		sort.SliceStable(list, func(i, j int) bool {
+			return eventTime(list[i]).After(eventTime(list[j]))
+		})
+	}
+	byNewest(important)
+	byNewest(normal)
+
+	var out []string
+	for _, e := range append(important, normal...) {
+		if len(out) == limit {
+			break
+		}
+		line := e.Reason
+		if e.Severity != "" {
+			line = e.Severity + " " + line
+		}
+		if t := eventTime(e); !t.IsZero() {
+			line = t.UTC().Format(time.RFC3339) + " " + line
+		}
+		if e.Message != "" {
+			line += ": " + e.Message
+		}
+		out = append(out, strings.TrimSpace(line))
+	}
+	return out
+}
+
+func eventTime(e *models.V1Event) time.Time {
+	if e.Metadata == nil {
+		return time.Time{}
+	}
+	return time.Time(e.Metadata.CreationTimestamp)
+}
+
+// clusterFailureDiagnostic builds a diagnostic for a cluster wait that
+// failed or timed out, with a report of why the cluster is stuck as its
+// detail. detail, when set, comes before the report.
+func clusterFailureDiagnostic(c *client.V1Client, uid string, severity diag.Severity, summary, detail string) diag.Diagnostic {
+	report := collectClusterFailureReport(c, uid).String()
+	switch {
+	case detail == "":
+		detail = report
+	case report != "":
+		detail += "\n\n" + report
+	}
+	return diag.Diagnostic{Severity: severity, Summary: summary, Detail: detail}
+}
+
+// withClusterFailureReport adds a report of why the cluster is stuck to an
+// error from a cluster wait, for the paths that return errors rather than
+// diagnostics.
+func withClusterFailureReport(c *client.V1Client, uid string, err error) error {
+	report := collectClusterFailureReport(c, uid).String()
+	if report == "" {
+		return err
+	}
+	return fmt.Errorf("%w\n\n%s", err, report)
+}
@@ -0,0 +1,106 @@
+package spectrocloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The failure report fixtures live in mockCluster.go under clusterFailedUID.
+const clusterFailedUID = "cluster-uid-provisioning-failed"
+
+func TestCollectClusterFailureReport(t *testing.T) {
+	c := castV1Client(t, unitTestMockAPIClient)
+
+	r := collectClusterFailureReport(c, clusterFailedUID)
+	assert.Equal(t, "Provisioning", r.state)
+	assert.Equal(t, "UnHealthy", r.health)
+	assert.Equal(t, []string{"ControlPlaneReady=False (WaitingForNodes): 0 of 3 control plane nodes are ready"}, r.conditions)
+	assert.Equal(t, []string{
+		"MachinePool cp-pool: 2 of 3 machines are not ready",
+		"Node cp-pool-node-1: kubelet stopped posting node status",
+	}, r.unhealthy)
+	assert.Equal(t, []string{"csi-aws-ebs=False: chart install failed"}, r.packs)
+	assert.Equal(t, []string{
+		"2026-01-01T10:10:00Z Warning NodeNotReady: cp-pool-node-1 is not ready",
+		"2026-01-01T10:05:00Z Warning PackInstallFailed: csi-aws-ebs: chart install failed",
+		"2026-01-01T10:00:00Z Normal Provisioning: Cluster provisioning started",
+	}, r.events)
+
+	healthy := collectClusterFailureReport(c, "cluster-uid-provisioning")
+	assert.Equal(t, "Cluster state: Provisioning", healthy.String(), "a healthy cluster with no conditions or events reports only its state")
+}
+
+func TestClusterFailureReportString(t *testing.T) {
+	assert.Empty(t, clusterFailureReport{}.String())
+
+	r := clusterFailureReport{
+		state:      "Provisioning",
+		health:     "UnHealthy",
+		conditions: []string{"ControlPlaneReady=False"},
+		events:     []string{"Warning NodeNotReady"},
+	}
+	assert.Equal(t, "Cluster state: Provisioning (health: UnHealthy)\n\n"+
+		"Unmet conditions:\n  - ControlPlaneReady=False\n\n"+
+		"Recent events:\n  - Warning NodeNotReady", r.String())
+}
+
+func TestRecentClusterEvents(t *testing.T) {
+	at := func(min int) *models.V1ObjectMeta {
+		return &models.V1ObjectMeta{CreationTimestamp: models.V1Time(time.Date(2026, 1, 1, 10, min, 0, 0, time.UTC))}
+	}
+	events := []*models.V1Event{
+		{Metadata: at(1), Severity: "Normal", Reason: "A"},
+		{Metadata: at(2), Severity: "Warning", Reason: "B"},
+		nil,
+		{Metadata: at(3), Severity: "Normal", Reason: "C"},
+		{Metadata: at(0), Severity: "Error", Reason: "D", Message: "boom"},
+		{Reason: "E"},
+	}
+
+	assert.Equal(t, []string{
+		"2026-01-01T10:02:00Z Warning B",
+		"2026-01-01T10:00:00Z Error D: boom",
+		"2026-01-01T10:03:00Z Normal C",
+	}, recentClusterEvents(events, 3), "warnings and errors come first, then the newest of the rest")
+	assert.Len(t, recentClusterEvents(events, 10), 5)
+	assert.Empty(t, recentClusterEvents(nil, 5))
+}
+
+func TestClusterFailureDiagnostic(t *testing.T) {
+	c := castV1Client(t, unitTestMockAPIClient)
+
+	d := clusterFailureDiagnostic(c, clusterFailedUID, diag.Warning, "Cluster creation timeout", "timed out")
+	assert.Equal(t, diag.Warning, d.Severity)
+	assert.Equal(t, "Cluster creation timeout", d.Summary)
+	assert.Contains(t, d.Detail, "timed out\n\nCluster state: Provisioning")
+	assert.Contains(t, d.Detail, "Packs not ready:\n  - csi-aws-ebs=False: chart install failed")
+
+	err := withClusterFailureReport(c, clusterFailedUID, errors.New("profile download failed"))
+	assert.Contains(t, err.Error(), "profile download failed\n\nCluster state: Provisioning")
+
+	err = withClusterFailureReport(c, "cluster-uid-not-found", errors.New("gone"))
+	assert.EqualError(t, err, "gone", "nothing to report leaves the error untouched")
+}
+
+// TestWaitForClusterReady_FailureReport checks the report reaches the
+// diagnostic Terraform shows when the wait fails.
+func TestWaitForClusterReady_FailureReport(t *testing.T) {
+	c := castV1Client(t, unitTestMockAPIClient)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	d := resourceClusterAws().TestResourceData()
+	diags, hadError := waitForClusterReady(ctx, d, clusterFailedUID, nil, c, nil)
+	require.True(t, hadError)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Cluster did not become ready", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "Unhealthy machine pools and nodes:\n  - MachinePool cp-pool")
+	assert.Contains(t, diags[0].Detail, "Recent events:\n  - 2026-01-01T10:10:00Z Warning NodeNotReady")
+}
@@ -84,7 +84,7 @@ func TestWaitForNodeMaintenanceCompleted(t *testing.T) {
 	// (already declared in cluster_node_common_test.go) reports
 	// State="Completed" which matches the wait's target.
 	err, isErr := waitForNodeMaintenanceCompleted(c, context.Background(),
-		dummyMaintenanceStatusB12, "cfg-uid", "mp-1", "node-1")
+		dummyMaintenanceStatusB12, "cfg-uid", "mp-1", "node-1", defaultWaitSettings)
 	_ = err
 	_ = isErr
 }
@@ -23,15 +23,14 @@ type GetMaintenanceStatus func(string, string, string) (*models.V1MachineMainten
 
 type GetNodeStatusMap func(string, string) (map[string]models.V1CloudMachineStatus, error)
 
-func waitForNodeMaintenanceCompleted(c *client.V1Client, ctx context.Context, fn GetMaintenanceStatus, ConfigUID, MachineName, NodeId string) (error, bool) {
+func waitForNodeMaintenanceCompleted(c *client.V1Client, ctx context.Context, fn GetMaintenanceStatus, ConfigUID, MachineName, NodeId string, wait waitSettings) (error, bool) {
 	stateConf := &retry.StateChangeConf{
-		Delay:      resolveWaitDelay(30 * time.Second),
-		Pending:    NodeMaintenanceLifecycleStates,
-		Target:     []string{"Completed"},
-		Refresh:    resourceClusterNodeMaintenanceRefreshFunc(c, fn, ConfigUID, MachineName, NodeId),
-		Timeout:    30 * time.Minute,
-		MinTimeout: 10 * time.Second,
+		Pending: NodeMaintenanceLifecycleStates,
+		Target:  []string{"Completed"},
+		Refresh: resourceClusterNodeMaintenanceRefreshFunc(c, fn, ConfigUID, MachineName, NodeId),
+		Timeout: 30 * time.Minute,
 	}
+	wait.apply(stateConf)
 
 	// Wait, catching any errors
 	_, err := stateConf.WaitForStateContext(ctx)
@@ -55,7 +54,7 @@ func resourceClusterNodeMaintenanceRefreshFunc(c *client.V1Client, fn GetMainten
 	}
 }
 
-func resourceNodeAction(c *client.V1Client, ctx context.Context, newMachinePool interface{}, fn GetMaintenanceStatus, CloudType, ConfigUID, MachineName string) error {
+func resourceNodeAction(c *client.V1Client, ctx context.Context, newMachinePool interface{}, fn GetMaintenanceStatus, CloudType, ConfigUID, MachineName string, wait waitSettings) error {
 	newNodes := newMachinePool.(map[string]interface{})["node"]
 	if newNodes != nil {
 		for _, n := range newNodes.([]interface{}) {
@@ -72,7 +71,7 @@ func resourceNodeAction(c *client.V1Client, ctx context.Context, newMachinePool
 				if err != nil {
 					return err
 				}
-				err, isError := waitForNodeMaintenanceCompleted(c, ctx, fn, ConfigUID, MachineName, node["node_id"].(string))
+				err, isError := waitForNodeMaintenanceCompleted(c, ctx, fn, ConfigUID, MachineName, node["node_id"].(string), wait)
 				if isError {
 					return err
 				}
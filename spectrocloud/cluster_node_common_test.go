@@ -121,7 +121,7 @@ func TestResourceNodeAction_NoNodes(t *testing.T) {
 	// nil is returned.
 	err := resourceNodeAction(c, contextB12(),
 		map[string]interface{}{"name": "mp-1"},
-		dummyMaintenanceStatusB12, "aws", "cfg-uid", "mp-1")
+		dummyMaintenanceStatusB12, "aws", "cfg-uid", "mp-1", defaultWaitSettings)
 	assert.NoError(t, err)
 }
 
@@ -139,7 +139,7 @@ func TestResourceNodeAction_NoActionChange(t *testing.T) {
 				},
 			},
 		},
-		dummyMaintenanceStatusB12, "aws", "cfg-uid", "mp-1")
+		dummyMaintenanceStatusB12, "aws", "cfg-uid", "mp-1", defaultWaitSettings)
 	assert.NoError(t, err)
 }
 
@@ -14,15 +14,14 @@ var resourceClusterProfileUpdatePendingStates = []string{
 	"false",
 }
 
-func waitForProfileDownload(ctx context.Context, c *client.V1Client, scope, id string, timeout time.Duration) error {
+func waitForProfileDownload(ctx context.Context, c *client.V1Client, scope, id string, timeout time.Duration, wait waitSettings) error {
 	stateConf := &retry.StateChangeConf{
-		Pending:    resourceClusterProfileUpdatePendingStates,
-		Target:     []string{"true"}, // canBeApplied=true
-		Refresh:    resourceClusterProfileStateRefreshFunc(c, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      resolveWaitDelay(30 * time.Second),
+		Pending: resourceClusterProfileUpdatePendingStates,
+		Target:  []string{"true"}, // canBeApplied=true
+		Refresh: resourceClusterProfileStateRefreshFunc(c, id),
+		Timeout: timeout,
 	}
+	wait.apply(stateConf)
 
 	_, err := stateConf.WaitForStateContext(ctx)
 
@@ -66,7 +66,7 @@ func TestWaitForProfileDownload_ContextCancelled(t *testing.T) {
 	// A cancelled context makes WaitForStateContext return immediately;
 	// we don't pay the 30s initial Delay. This exercises the error
 	// return of waitForProfileDownload without the wait.
-	err := waitForProfileDownload(ctx, c, "project", "test-cluster-id", 5*time.Second)
+	err := waitForProfileDownload(ctx, c, "project", "test-cluster-id", 5*time.Second, defaultWaitSettings)
 	require.Error(t, err)
 }
 
@@ -80,6 +80,6 @@ func TestWaitForProfileDownload_TargetReached(t *testing.T) {
 		t.Skip("skipping 30s state-machine test in -short mode")
 	}
 	c := castV1Client(t, unitTestMockAPIClient)
-	err := waitForProfileDownload(context.Background(), c, "project", "test-cluster-id", 2*time.Minute)
+	err := waitForProfileDownload(context.Background(), c, "project", "test-cluster-id", 2*time.Minute, defaultWaitSettings)
 	assert.NoError(t, err, "canBeApplied=true from the mock's first response must satisfy Target")
 }
@@ -0,0 +1,253 @@
+package spectrocloud
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/palette-sdk-go/client"
+)
+
+// validateClusterProfileVariables is the CustomizeDiff of cluster resources.
+// It checks the variables of each cluster_profile block against the
+// variables the profile defines: unknown names, missing required values,
+// and values that don't match the variable's format or regex fail the plan.
+// Profiles whose ID or variables are not known yet, or whose variables
+// can't be read, are skipped, as are plans made before the provider is
+// configured.
+func validateClusterProfileVariables(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" && !d.HasChange("cluster_profile") {
+		return nil
+	}
+	if !d.NewValueKnown("cluster_profile") {
+		return nil
+	}
+	// An unconfigured provider, e.g. one whose credentials are only known at
+	// apply time, can't read the profiles.
+	if _, ok := m.(*providerMeta); !ok || !d.NewValueKnown("project") {
+		return nil
+	}
+	profiles := normalizeInterfaceSliceFromListOrSet(d.Get("cluster_profile"))
+	if len(profiles) == 0 {
+		return nil
+	}
+	// The profiles are read from the cluster's project, not the provider's.
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return err
+	}
+
+	clusterContext, _ := d.Get("context").(string)
+	c := getV1ClientWithResourceContext(m, clusterContext)
+	errs, _ := checkClusterProfilesVariables(ctx, c, profiles, clusterProfilesWithUnknownVariables(d.GetRawConfig()))
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid cluster profile variables:\n  %s", strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
+// clusterProfileVariablesWarnings returns a warning for each variable of the
+// cluster_profile blocks that is left to its default value, when the blocks
+// are created or changed. m must already be scoped to the resource's
+// project.
+func clusterProfileVariablesWarnings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.IsNewResource() && !d.HasChange("cluster_profile") {
+		return nil
+	}
+	profiles := normalizeInterfaceSliceFromListOrSet(d.Get("cluster_profile"))
+	if len(profiles) == 0 {
+		return nil
+	}
+	clusterContext, _ := d.Get("context").(string)
+	_, defaults := checkClusterProfilesVariables(ctx, getV1ClientWithResourceContext(m, clusterContext), profiles, nil)
+	var diags diag.Diagnostics
+	for _, w := range defaults {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Cluster profile variable default applied",
+			Detail:   w,
+		})
+	}
+	return diags
+}
+
+// checkClusterProfilesVariables checks the variables of the cluster_profile
+// blocks in profiles, except those of the profile IDs in skip. It returns
+// the problems found and a message for each variable that falls back to its
+// default value.
+func checkClusterProfilesVariables(ctx context.Context, c *client.V1Client, profiles []interface{}, skip map[string]bool) (errs, defaults []string) {
+	for _, raw := range profiles {
+		p, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		uid, _ := p["id"].(string)
+		if uid == "" || skip[uid] {
+			continue
+		}
+		definitions, err := c.GetProfileVariables(uid)
+		if err != nil {
+			tflog.Debug(ctx, "Skipping validation of cluster profile variables", map[string]interface{}{"profile": uid, "error": err.Error()})
+			continue
+		}
+		values, _ := p["variables"].(map[string]interface{})
+
+		profileErrs, profileDefaults := checkProfileVariables(definitions, values)
+		for _, e := range profileErrs {
+			errs = append(errs, fmt.Sprintf("cluster profile %s: %s", uid, e))
+		}
+		for _, v := range profileDefaults {
+			if v.IsSensitive {
+				defaults = append(defaults, fmt.Sprintf("cluster profile %s: variable %s is not set, its default value will be applied", uid, *v.Name))
+				continue
+			}
+			defaults = append(defaults, fmt.Sprintf("cluster profile %s: variable %s is not set, its default value %q will be applied", uid, *v.Name, v.DefaultValue))
+		}
+	}
+	return errs, defaults
+}
+
+// clusterProfilesWithUnknownVariables returns the IDs of the cluster_profile
+// blocks of config whose variables are not wholly known. The SDK reads a map
+// holding an unknown value as nil, which would report every variable as
+// unset. Blocks whose ID is unknown read as an empty ID and are skipped
+// anyway. cluster_profile may be a set, so each element is checked rather
+// than the attribute as a whole.
+func clusterProfilesWithUnknownVariables(config cty.Value) map[string]bool {
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute("cluster_profile") {
+		return nil
+	}
+	blocks := config.GetAttr("cluster_profile")
+	if blocks.IsNull() || !blocks.IsKnown() || !blocks.CanIterateElements() {
+		return nil
+	}
+	unknown := make(map[string]bool)
+	for it := blocks.ElementIterator(); it.Next(); {
+		_, block := it.Element()
+		if block.IsNull() || !block.IsKnown() || !block.Type().IsObjectType() ||
+			!block.Type().HasAttribute("id") || !block.Type().HasAttribute("variables") {
+			continue
+		}
+		id := block.GetAttr("id")
+		if id.IsNull() || !id.IsKnown() {
+			continue
+		}
+		if !block.GetAttr("variables").IsWhollyKnown() {
+			unknown[id.AsString()] = true
+		}
+	}
+	return unknown
+}
+
+// checkProfileVariables checks the configured values of a profile's
+// variables against their definitions. It returns the problems found and
+// the variables that fall back to their default value.
+func checkProfileVariables(definitions []*models.V1Variable, values map[string]interface{}) (errs []string, defaults []*models.V1Variable) {
+	defined := make(map[string]bool, len(definitions))
+	for _, v := range definitions {
+		if v == nil || v.Name == nil {
+			continue
+		}
+		name := *v.Name
+		defined[name] = true
+
+		raw, set := values[name]
+		value, _ := raw.(string)
+		if !set {
+			switch {
+			case v.DefaultValue != "":
+				defaults = append(defaults, v)
+			case v.Required:
+				errs = append(errs, fmt.Sprintf("variable %s is required and has no default value", name))
+			}
+			continue
+		}
+		if err := checkProfileVariableValue(v, value); err != nil {
+			errs = append(errs, fmt.Sprintf("variable %s: %s", name, err))
+		}
+	}
+
+	var unknown []string
+	for name := range values {
+		if !defined[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		errs = append(errs, fmt.Sprintf("variable %s is not defined by the profile", name))
+	}
+	return errs, defaults
+}
+
+// checkProfileVariableValue checks a value against the format and regex of
+// its variable. Values holding Palette macros are only resolved at deploy
+// time and are not checked.
+func checkProfileVariableValue(v *models.V1Variable, value string) error {
+	if value == "" {
+		if v.Required {
+			return fmt.Errorf("a value is required")
+		}
+		return nil
+	}
+	if strings.Contains(value, "{{") {
+		return nil
+	}
+
+	if v.Format != nil {
+		if err := checkProfileVariableFormat(*v.Format, value); err != nil {
+			return err
+		}
+	}
+	if v.Regex != "" {
+		re, err := regexp.Compile(v.Regex)
+		if err == nil && !re.MatchString(value) {
+			if v.IsSensitive {
+				return fmt.Errorf("value does not match %s", v.Regex)
+			}
+			return fmt.Errorf("value %q does not match %s", value, v.Regex)
+		}
+	}
+	return nil
+}
+
+func checkProfileVariableFormat(format models.V1VariableFormat, value string) error {
+	valid := true
+	switch format {
+	case models.V1VariableFormatNumber:
+		_, err := strconv.ParseFloat(value, 64)
+		valid = err == nil
+	case models.V1VariableFormatBoolean:
+		valid = value == "true" || value == "false"
+	case models.V1VariableFormatIPV4:
+		ip := net.ParseIP(value)
+		valid = ip != nil && ip.To4() != nil
+	case models.V1VariableFormatIpv4cidr:
+		ip, _, err := net.ParseCIDR(value)
+		valid = err == nil && ip.To4() != nil
+	case models.V1VariableFormatIPV6:
+		ip := net.ParseIP(value)
+		valid = ip != nil && ip.To4() == nil
+	case models.V1VariableFormatVersion:
+		_, err := semver.NewVersion(value)
+		valid = err == nil
+	case models.V1VariableFormatBase64:
+		_, err := base64.StdEncoding.DecodeString(value)
+		valid = err == nil
+	}
+	if !valid {
+		return fmt.Errorf("value is not a valid %s", format)
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package spectrocloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+	"github.com/spectrocloud/terraform-provider-spectrocloud/types"
+)
+
+// clusterProfileVariablesUID defines variables in the mock API, see
+// tests/mockApiServer/routes/mockClusterProfile.go.
+const clusterProfileVariablesUID = "cluster-profile-variables-uid"
+
+func TestCheckProfileVariables(t *testing.T) {
+	format := func(f models.V1VariableFormat) *models.V1VariableFormat { return &f }
+	definitions := []*models.V1Variable{
+		{Name: types.Ptr("replicas"), Format: format(models.V1VariableFormatNumber), Required: true, DefaultValue: "1"},
+		{Name: types.Ptr("pod_cidr"), Format: format(models.V1VariableFormatIpv4cidr), Required: true},
+		{Name: types.Ptr("env"), Regex: "^(dev|prod)$", DefaultValue: "dev"},
+		{Name: types.Ptr("password"), Regex: "^.{12,}$", IsSensitive: true},
+		{Name: types.Ptr("enabled"), Format: format(models.V1VariableFormatBoolean)},
+		{Name: types.Ptr("vip"), Format: format(models.V1VariableFormatIPV4)},
+		{Name: types.Ptr("vip6"), Format: format(models.V1VariableFormatIPV6)},
+		{Name: types.Ptr("k8s_version"), Format: format(models.V1VariableFormatVersion)},
+		{Name: types.Ptr("ca"), Format: format(models.V1VariableFormatBase64)},
+	}
+
+	errs, defaults := checkProfileVariables(definitions, map[string]interface{}{
+		"replicas":    "3",
+		"pod_cidr":    "192.168.0.0/16",
+		"password":    "correct-horse-battery",
+		"enabled":     "true",
+		"vip":         "10.0.0.1",
+		"vip6":        "fd00::1",
+		"k8s_version": "1.30.4",
+		"ca":          "aGVsbG8=",
+	})
+	assert.Empty(t, errs)
+	assert.Equal(t, []*models.V1Variable{definitions[2]}, defaults)
+
+	errs, defaults = checkProfileVariables(definitions, map[string]interface{}{
+		"replicas":    "three",
+		"env":         "staging",
+		"password":    "short",
+		"enabled":     "yes",
+		"vip":         "fd00::1",
+		"vip6":        "10.0.0.1",
+		"k8s_version": "latest",
+		"ca":          "not base64!",
+		"extra":       "1",
+		"another":     "{{ .spectro.system.cluster.name }}",
+	})
+	assert.Equal(t, []string{
+		"variable replicas: value is not a valid number",
+		"variable pod_cidr is required and has no default value",
+		`variable env: value "staging" does not match ^(dev|prod)$`,
+		"variable password: value does not match ^.{12,}$",
+		"variable enabled: value is not a valid boolean",
+		"variable vip: value is not a valid ipv4",
+		"variable vip6: value is not a valid ipv6",
+		"variable k8s_version: value is not a valid version",
+		"variable ca: value is not a valid base64",
+		"variable another is not defined by the profile",
+		"variable extra is not defined by the profile",
+	}, errs)
+	assert.Empty(t, defaults)
+
+	errs, _ = checkProfileVariables(definitions, map[string]interface{}{"pod_cidr": "", "vip": "{{ .spectro.var.vip }}"})
+	assert.Equal(t, []string{"variable pod_cidr: a value is required"}, errs)
+}
+
+// clusterProfileVariablesDiff plans a new resource whose only attachment is
+// the given cluster_profile block.
+func clusterProfileVariablesDiff(profile map[string]interface{}, meta interface{}) (*terraform.InstanceDiff, error) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"context":         {Type: schema.TypeString, Optional: true, Default: "project"},
+			"cluster_profile": schemas.ClusterProfileSchemaV2(),
+		},
+		CustomizeDiff: validateClusterProfileVariables,
+	}
+	cfg := terraform.NewResourceConfigRaw(map[string]interface{}{"cluster_profile": []interface{}{profile}})
+	return r.Diff(context.Background(), nil, cfg, meta)
+}
+
+func TestValidateClusterProfileVariables(t *testing.T) {
+	_, err := clusterProfileVariablesDiff(map[string]interface{}{
+		"id":        clusterProfileVariablesUID,
+		"variables": map[string]interface{}{"pod_cidr": "192.168.0.0/16", "token": "dG9rZW4="},
+	}, unitTestMockAPIClient)
+	require.NoError(t, err)
+
+	_, err = clusterProfileVariablesDiff(map[string]interface{}{
+		"id":        clusterProfileVariablesUID,
+		"variables": map[string]interface{}{"replicas": "two", "env": "qa", "typo": "1"},
+	}, unitTestMockAPIClient)
+	assert.EqualError(t, err, "invalid cluster profile variables:\n"+
+		"  cluster profile "+clusterProfileVariablesUID+": variable replicas: value is not a valid number\n"+
+		"  cluster profile "+clusterProfileVariablesUID+": variable pod_cidr is required and has no default value\n"+
+		"  cluster profile "+clusterProfileVariablesUID+`: variable env: value "qa" does not match ^(dev|prod)$`+"\n"+
+		"  cluster profile "+clusterProfileVariablesUID+": variable typo is not defined by the profile")
+
+	_, err = clusterProfileVariablesDiff(map[string]interface{}{
+		"id":        "cluster-profile-import-1",
+		"variables": map[string]interface{}{"replicas": "2"},
+	}, unitTestMockAPIClient)
+	assert.EqualError(t, err, "invalid cluster profile variables:\n  cluster profile cluster-profile-import-1: variable replicas is not defined by the profile")
+
+	_, err = clusterProfileVariablesDiff(map[string]interface{}{"id": "cluster-profile-import-1"}, unitTestMockAPIClient)
+	assert.NoError(t, err)
+
+	_, err = clusterProfileVariablesDiff(map[string]interface{}{
+		"id":        clusterProfileVariablesUID,
+		"variables": map[string]interface{}{"replicas": "two"},
+	}, nil)
+	assert.NoError(t, err, "an unconfigured provider skips validation")
+}
+
+func TestValidateClusterProfileVariablesUnknownValues(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"context":         {Type: schema.TypeString, Optional: true, Default: "project"},
+			"cluster_profile": schemas.ClusterProfileSchemaV2(),
+		},
+		CustomizeDiff: validateClusterProfileVariables,
+	}
+	// Terraform hands the configuration over as a cty value, which is where a
+	// value only known at apply time, such as another module's output, shows.
+	diff := func(variables cty.Value) error {
+		config, err := r.CoreConfigSchema().CoerceValue(cty.ObjectVal(map[string]cty.Value{
+			"cluster_profile": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"id":        cty.StringVal(clusterProfileVariablesUID),
+				"variables": variables,
+			})}),
+		}))
+		require.NoError(t, err)
+		_, err = r.Diff(context.Background(), &terraform.InstanceState{RawConfig: config},
+			terraform.NewResourceConfigShimmed(config, r.CoreConfigSchema()), unitTestMockAPIClient)
+		return err
+	}
+
+	assert.NoError(t, diff(cty.MapVal(map[string]cty.Value{
+		"pod_cidr": cty.UnknownVal(cty.String),
+		"replicas": cty.StringVal("2"),
+	})), "variables that are not known yet are not validated")
+	assert.ErrorContains(t, diff(cty.MapVal(map[string]cty.Value{
+		"pod_cidr": cty.StringVal("192.168.0.0/16"),
+		"replicas": cty.StringVal("two"),
+	})), "variable replicas: value is not a valid number")
+}
+
+func TestClusterProfileVariablesWarnings(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"context":         {Type: schema.TypeString, Optional: true, Default: "project"},
+			"cluster_profile": schemas.ClusterProfileSchemaV2(),
+		},
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"cluster_profile": []interface{}{map[string]interface{}{
+			"id":        clusterProfileVariablesUID,
+			"variables": map[string]interface{}{"pod_cidr": "192.168.0.0/16"},
+		}},
+	})
+	diags := clusterProfileVariablesWarnings(context.Background(), d, unitTestMockAPIClient)
+	require.Len(t, diags, 2)
+	for _, w := range diags {
+		assert.Equal(t, diag.Warning, w.Severity)
+		assert.Equal(t, "Cluster profile variable default applied", w.Summary)
+	}
+	assert.Equal(t, "cluster profile "+clusterProfileVariablesUID+`: variable replicas is not set, its default value "1" will be applied`, diags[0].Detail)
+	assert.Equal(t, "cluster profile "+clusterProfileVariablesUID+`: variable env is not set, its default value "dev" will be applied`, diags[1].Detail)
+}
+
+func TestValidateClusterProfileVariablesProject(t *testing.T) {
+	meta, rec := newProjectScopeMeta(t)
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"context":         {Type: schema.TypeString, Optional: true, Default: "project"},
+			"project":         schemas.ProjectSchema(),
+			"cluster_profile": schemas.ClusterProfileSchemaV2(),
+		},
+		CustomizeDiff: validateClusterProfileVariables,
+	}
+	cfg := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project":         "Dev",
+		"cluster_profile": []interface{}{map[string]interface{}{"id": clusterProfileVariablesUID}},
+	})
+	_, err := r.Diff(context.Background(), nil, cfg, meta)
+	require.NoError(t, err)
+	projects, _ := rec.seen()
+	require.NotEmpty(t, projects)
+	for _, p := range projects {
+		assert.Equal(t, "devprojectuid", p, "the profile variables are read from the cluster's project")
+	}
+
+	cfg = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project":         "Staging",
+		"cluster_profile": []interface{}{map[string]interface{}{"id": clusterProfileVariablesUID}},
+	})
+	_, err = r.Diff(context.Background(), nil, cfg, meta)
+	assert.ErrorContains(t, err, `project "Staging" not found`)
+}
@@ -0,0 +1,399 @@
+package spectrocloud
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/palette-sdk-go/client"
+	"gopkg.in/yaml.v3"
+)
+
+// clusterWaitForMet is the state resourceClusterWaitForRefreshFunc reports
+// once every wait_for condition holds.
+const clusterWaitForMet = "WaitFor:Met"
+
+// resourceClusterWaitForPendingStates are the states a cluster can pass
+// through on its way to meeting its wait_for conditions. Running-Healthy is
+// pending too: a healthy cluster can still be missing a pack or a reachable
+// API server.
+var resourceClusterWaitForPendingStates = append([]string{"Running-Healthy"}, resourceClusterCreatePendingStates...)
+
+// clusterWaitFor is what a cluster resource's wait_for block asks of the
+// cluster before its creation is complete.
+type clusterWaitFor struct {
+	target              string
+	machinePoolsReady   bool
+	packsDeployed       bool
+	healthyPacks        []string
+	kubeconfigReachable bool
+}
+
+// defaultClusterWaitFor is what the provider waits for when a cluster has no
+// wait_for block: a Running-Healthy cluster.
+var defaultClusterWaitFor = clusterWaitFor{target: "running_healthy"}
+
+// clusterWaitForFrom reads d's wait_for block. d may belong to a resource
+// without one, in which case the default applies.
+func clusterWaitForFrom(d *schema.ResourceData) clusterWaitFor {
+	block, _ := d.Get("wait_for").([]interface{})
+	if len(block) == 0 || block[0] == nil {
+		return defaultClusterWaitFor
+	}
+	o := block[0].(map[string]interface{})
+	w := clusterWaitFor{
+		target:              o["target"].(string),
+		machinePoolsReady:   o["machine_pools_ready"].(bool),
+		packsDeployed:       o["packs_deployed"].(bool),
+		kubeconfigReachable: o["kubeconfig_reachable"].(bool),
+	}
+	if w.target == "" {
+		w.target = defaultClusterWaitFor.target
+	}
+	if packs, ok := o["healthy_packs"].(*schema.Set); ok {
+		for _, p := range packs.List() {
+			w.healthyPacks = append(w.healthyPacks, p.(string))
+		}
+	}
+	return w
+}
+
+// isDefault reports whether w asks for nothing beyond a Running-Healthy
+// cluster.
+func (w clusterWaitFor) isDefault() bool {
+	return w.target == defaultClusterWaitFor.target && !w.machinePoolsReady && !w.packsDeployed &&
+		len(w.healthyPacks) == 0 && !w.kubeconfigReachable
+}
+
+// unmet returns the wait_for conditions cluster, currently in state (as
+// resourceClusterStateRefreshFunc reports it), does not meet yet. An error
+// means one of them can no longer be met.
+func (w clusterWaitFor) unmet(ctx context.Context, c *client.V1Client, cluster *models.V1SpectroCluster, state string) ([]string, error) {
+	var unmet []string
+	uid := cluster.Metadata.UID
+
+	var overview *models.V1SpectroClusterUIDSummary
+	if w.target == "control_plane_ready" || w.machinePoolsReady {
+		var err error
+		if overview, err = c.GetClusterOverview(uid); err != nil {
+			return nil, err
+		}
+	}
+
+	switch w.target {
+	case "running":
+		if state != "Running" && state != "Running-Healthy" {
+			unmet = append(unmet, fmt.Sprintf("cluster is %s, not Running", state))
+		}
+	case "control_plane_ready":
+		if overview == nil || overview.Status == nil || overview.Status.KubeMeta == nil || !overview.Status.KubeMeta.HasKubeConfig {
+			unmet = append(unmet, fmt.Sprintf("control plane is not ready, cluster is %s", state))
+		}
+	default:
+		if state != "Running-Healthy" {
+			unmet = append(unmet, fmt.Sprintf("cluster is %s, not Running-Healthy", state))
+		}
+	}
+
+	if w.machinePoolsReady {
+		unmet = append(unmet, machinePoolsNotReady(overview)...)
+	}
+
+	var packs []*models.V1ClusterPackStatus
+	if cluster.Status != nil {
+		packs = cluster.Status.Packs
+	}
+	if w.packsDeployed {
+		if len(packs) == 0 {
+			unmet = append(unmet, "no packs are reported yet")
+		}
+		for _, p := range packs {
+			notReady, err := clusterPackNotReady(p)
+			if err != nil {
+				return nil, err
+			}
+			if notReady != "" {
+				unmet = append(unmet, notReady)
+			}
+		}
+	}
+	for _, name := range w.healthyPacks {
+		var pack *models.V1ClusterPackStatus
+		for _, p := range packs {
+			if p != nil && p.Name == name {
+				pack = p
+				break
+			}
+		}
+		if pack == nil {
+			unmet = append(unmet, fmt.Sprintf("pack %s is not deployed yet", name))
+			continue
+		}
+		notReady, err := clusterPackNotReady(pack)
+		if err != nil {
+			return nil, err
+		}
+		if notReady != "" && !w.packsDeployed {
+			unmet = append(unmet, notReady)
+		}
+	}
+
+	// Only probe the API server once everything else holds; there is no
+	// kubeconfig to probe with before the control plane is up.
+	if w.kubeconfigReachable && len(unmet) == 0 {
+		kubeconfig, err := c.GetClusterAdminKubeConfig(uid)
+		switch {
+		case err != nil || kubeconfig == "":
+			unmet = append(unmet, "kubeconfig is not issued yet")
+		default:
+			if err := probeKubeconfig(ctx, kubeconfig); err != nil {
+				unmet = append(unmet, fmt.Sprintf("API server is not reachable through the kubeconfig: %v", err))
+			}
+		}
+	}
+	return unmet, nil
+}
+
+// machinePoolsNotReady describes the machine pools in overview that have
+// fewer healthy nodes than their desired size. Pools are named after their
+// labels, as the overview doesn't carry pool names.
+func machinePoolsNotReady(overview *models.V1SpectroClusterUIDSummary) []string {
+	if overview == nil || overview.Spec == nil || overview.Spec.CloudConfig == nil || len(overview.Spec.CloudConfig.MachinePools) == 0 {
+		return []string{"machine pool sizes are not reported yet"}
+	}
+	var unmet []string
+	for i, mp := range overview.Spec.CloudConfig.MachinePools {
+		if mp == nil || mp.Healthy >= mp.Size {
+			continue
+		}
+		name := fmt.Sprintf("machine pool %d", i+1)
+		if len(mp.Labels) > 0 {
+			name = fmt.Sprintf("%s machine pool", strings.Join(mp.Labels, ", "))
+		}
+		unmet = append(unmet, fmt.Sprintf("%s has %d of %d nodes ready", name, mp.Healthy, mp.Size))
+	}
+	return unmet
+}
+
+// clusterPackNotReady describes p if it is not deployed and ready yet, the
+// way resourceAddonDeploymentStateRefreshFunc judges packs. A pack in error
+// is returned as an error.
+func clusterPackNotReady(p *models.V1ClusterPackStatus) (string, error) {
+	if p == nil {
+		return "", nil
+	}
+	cond := p.Condition
+	if cond == nil {
+		return fmt.Sprintf("pack %s is not ready", p.Name), nil
+	}
+	if cond.Type != nil && *cond.Type == "Error" {
+		return "", fmt.Errorf("pack %s failed: %s", p.Name, cond.Message)
+	}
+	if cond.Type == nil || *cond.Type != "Ready" || cond.Status == nil || *cond.Status != "True" {
+		if cond.Message != "" {
+			return fmt.Sprintf("pack %s is not ready: %s", p.Name, cond.Message), nil
+		}
+		return fmt.Sprintf("pack %s is not ready", p.Name), nil
+	}
+	return "", nil
+}
+
+// resourceClusterWaitForRefreshFunc reports clusterWaitForMet once the
+// cluster meets w, and the cluster's state until then. The conditions last
+// found unmet are kept in *unmet for the timeout diagnostic.
+func resourceClusterWaitForRefreshFunc(ctx context.Context, c *client.V1Client, id string, w clusterWaitFor, unmet *[]string) retry.StateRefreshFunc {
+	refresh := resourceClusterStateRefreshFunc(c, id)
+	return func() (interface{}, string, error) {
+		result, state, err := refresh()
+		if err != nil || result == nil {
+			return result, state, err
+		}
+		cluster := result.(*models.V1SpectroCluster)
+
+		missing, err := w.unmet(ctx, c, cluster, state)
+		if err != nil {
+			return nil, "", err
+		}
+		*unmet = missing
+		if len(missing) > 0 {
+			log.Printf("Cluster (%s) wait_for conditions not met: %s", id, strings.Join(missing, "; "))
+			return cluster, state, nil
+		}
+		return cluster, clusterWaitForMet, nil
+	}
+}
+
+// kubeconfigFile is the part of a kubeconfig probeKubeconfig and
+// parseClusterKubeconfig read.
+type kubeconfigFile struct {
+	CurrentContext string              `yaml:"current-context"`
+	Clusters       []kubeconfigCluster `yaml:"clusters"`
+	Contexts       []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []kubeconfigUser `yaml:"users"`
+}
+
+type kubeconfigCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		Server                   string `yaml:"server"`
+		CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify"`
+	} `yaml:"cluster"`
+}
+
+type kubeconfigUser struct {
+	Name string `yaml:"name"`
+	User struct {
+		ClientCertificateData string `yaml:"client-certificate-data"`
+		ClientKeyData         string `yaml:"client-key-data"`
+		Token                 string `yaml:"token"`
+		AuthProvider          *struct {
+			Name   string            `yaml:"name"`
+			Config map[string]string `yaml:"config"`
+		} `yaml:"auth-provider"`
+		Exec *struct {
+			APIVersion string   `yaml:"apiVersion"`
+			Command    string   `yaml:"command"`
+			Args       []string `yaml:"args"`
+			Env        []struct {
+				Name  string `yaml:"name"`
+				Value string `yaml:"value"`
+			} `yaml:"env"`
+		} `yaml:"exec"`
+	} `yaml:"user"`
+}
+
+// resolveContext returns the name of the context contextName stands for,
+// with the cluster and user it refers to. An empty contextName stands for
+// the current context, or the only context when none is current. The user
+// is nil when the context names none of the kubeconfig's users.
+func (kc *kubeconfigFile) resolveContext(contextName string) (string, *kubeconfigCluster, *kubeconfigUser, error) {
+	if contextName == "" {
+		contextName = kc.CurrentContext
+		if contextName == "" && len(kc.Contexts) == 1 {
+			contextName = kc.Contexts[0].Name
+		}
+	}
+	clusterName, userName, found := "", "", false
+	for _, kctx := range kc.Contexts {
+		if kctx.Name == contextName {
+			clusterName, userName, found = kctx.Context.Cluster, kctx.Context.User, true
+		}
+	}
+	if !found {
+		if contextName == "" {
+			return "", nil, nil, errors.New("kubeconfig has no current context")
+		}
+		return "", nil, nil, fmt.Errorf("kubeconfig has no context %q", contextName)
+	}
+
+	var cluster *kubeconfigCluster
+	for i := range kc.Clusters {
+		if kc.Clusters[i].Name == clusterName {
+			cluster = &kc.Clusters[i]
+		}
+	}
+	if cluster == nil || cluster.Cluster.Server == "" {
+		return "", nil, nil, fmt.Errorf("kubeconfig has no server for context %q", contextName)
+	}
+	var user *kubeconfigUser
+	for i := range kc.Users {
+		if kc.Users[i].Name == userName {
+			user = &kc.Users[i]
+		}
+	}
+	return contextName, cluster, user, nil
+}
+
+// probeKubeconfig checks that the API server of kubeconfig's current
+// context answers its /version endpoint with the credentials kubeconfig
+// carries.
+func probeKubeconfig(ctx context.Context, kubeconfig string) error {
+	var kc kubeconfigFile
+	if err := yaml.Unmarshal([]byte(kubeconfig), &kc); err != nil {
+		return fmt.Errorf("parsing kubeconfig: %w", err)
+	}
+	_, cluster, user, err := kc.resolveContext("")
+	if err != nil {
+		return err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cluster.Cluster.InsecureSkipTLSVerify, // #nosec G402 -- honors the kubeconfig's own setting
+	}
+	if cluster.Cluster.CertificateAuthorityData != "" {
+		ca, err := base64.StdEncoding.DecodeString(cluster.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return fmt.Errorf("decoding certificate-authority-data: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return errors.New("certificate-authority-data holds no PEM certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	token := ""
+	if user != nil {
+		token = user.User.Token
+		if user.User.ClientCertificateData != "" && user.User.ClientKeyData != "" {
+			certPEM, err := base64.StdEncoding.DecodeString(user.User.ClientCertificateData)
+			if err != nil {
+				return fmt.Errorf("decoding client-certificate-data: %w", err)
+			}
+			keyPEM, err := base64.StdEncoding.DecodeString(user.User.ClientKeyData)
+			if err != nil {
+				return fmt.Errorf("decoding client-key-data: %w", err)
+			}
+			cert, err := tls.X509KeyPair(certPEM, keyPEM)
+			if err != nil {
+				return fmt.Errorf("loading client certificate: %w", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+	}
+
+	httpClient := &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}
+	// Every poll builds its own transport; release its connection so the
+	// wait doesn't leave one open per attempt.
+	defer httpClient.CloseIdleConnections()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(cluster.Cluster.Server, "/")+"/version", nil)
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET /version returned %s", resp.Status)
+	}
+	return nil
+}
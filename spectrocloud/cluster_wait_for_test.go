@@ -0,0 +1,160 @@
+package spectrocloud
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterWaitForFrom(t *testing.T) {
+	d := resourceClusterAws().TestResourceData()
+	assert.Equal(t, defaultClusterWaitFor, clusterWaitForFrom(d))
+	assert.True(t, clusterWaitForFrom(d).isDefault())
+
+	require.NoError(t, d.Set("wait_for", []interface{}{map[string]interface{}{
+		"target":               "running",
+		"packs_deployed":       true,
+		"healthy_packs":        []interface{}{"csi-aws-ebs"},
+		"kubeconfig_reachable": true,
+	}}))
+	w := clusterWaitForFrom(d)
+	assert.Equal(t, clusterWaitFor{
+		target:              "running",
+		packsDeployed:       true,
+		healthyPacks:        []string{"csi-aws-ebs"},
+		kubeconfigReachable: true,
+	}, w)
+	assert.False(t, w.isDefault())
+
+	// Resources without a wait_for block get the default.
+	assert.Equal(t, defaultClusterWaitFor, clusterWaitForFrom(resourceClusterBrownfield().TestResourceData()))
+}
+
+func TestClusterWaitForUnmet(t *testing.T) {
+	c := castV1Client(t, unitTestMockAPIClient)
+	failed, _ := c.GetClusterWithoutStatus(clusterFailedUID)
+	require.NotNil(t, failed)
+
+	tests := []struct {
+		name  string
+		w     clusterWaitFor
+		state string
+		want  []string
+	}{
+		{"running_healthy reached", defaultClusterWaitFor, "Running-Healthy", nil},
+		{"running_healthy pending", defaultClusterWaitFor, "Running", []string{"cluster is Running, not Running-Healthy"}},
+		{"running accepts an unhealthy cluster", clusterWaitFor{target: "running"}, "Running", nil},
+		{"control plane without a kubeconfig", clusterWaitFor{target: "control_plane_ready"}, "Provisioning",
+			[]string{"control plane is not ready, cluster is Provisioning"}},
+		{"machine pools", clusterWaitFor{target: "running", machinePoolsReady: true}, "Running", []string{
+			"control-plane machine pool has 1 of 3 nodes ready",
+			"machine pool 3 has 0 of 2 nodes ready",
+		}},
+		{"all packs", clusterWaitFor{target: "running", packsDeployed: true}, "Running", []string{
+			"pack csi-aws-ebs is not ready: chart install failed",
+			"pack cni-calico is not ready",
+		}},
+		{"named packs", clusterWaitFor{target: "running", healthyPacks: []string{"csi-aws-ebs", "nginx"}}, "Running", []string{
+			"pack csi-aws-ebs is not ready: chart install failed",
+			"pack nginx is not deployed yet",
+		}},
+		{"kubeconfig is only probed once the rest holds", clusterWaitFor{target: "running_healthy", kubeconfigReachable: true}, "Provisioning",
+			[]string{"cluster is Provisioning, not Running-Healthy"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unmet, err := tt.w.unmet(context.Background(), c, failed, tt.state)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, unmet)
+		})
+	}
+}
+
+func TestMachinePoolsNotReady(t *testing.T) {
+	assert.Equal(t, []string{"machine pool sizes are not reported yet"}, machinePoolsNotReady(nil))
+	assert.Empty(t, machinePoolsNotReady(&models.V1SpectroClusterUIDSummary{
+		Spec: &models.V1SpectroClusterUIDSummarySpec{CloudConfig: &models.V1CloudConfigMeta{
+			MachinePools: []*models.V1MachinePoolMeta{{Labels: []string{"worker"}, Size: 2, Healthy: 2}, nil},
+		}},
+	}))
+}
+
+func TestClusterPackNotReady(t *testing.T) {
+	cond := func(typ, status string) *models.V1ClusterCondition {
+		return &models.V1ClusterCondition{Type: &typ, Status: &status, Message: "boom"}
+	}
+
+	notReady, err := clusterPackNotReady(&models.V1ClusterPackStatus{Name: "a", Condition: cond("Ready", "True")})
+	require.NoError(t, err)
+	assert.Empty(t, notReady)
+
+	notReady, err = clusterPackNotReady(&models.V1ClusterPackStatus{Name: "a", Condition: cond("Ready", "False")})
+	require.NoError(t, err)
+	assert.Equal(t, "pack a is not ready: boom", notReady)
+
+	_, err = clusterPackNotReady(&models.V1ClusterPackStatus{Name: "a", Condition: cond("Error", "True")})
+	assert.EqualError(t, err, "pack a failed: boom")
+}
+
+func TestResourceClusterWaitForRefreshFunc(t *testing.T) {
+	c := castV1Client(t, unitTestMockAPIClient)
+
+	var unmet []string
+	_, state, err := resourceClusterWaitForRefreshFunc(context.Background(), c, "cluster-uid-running", defaultClusterWaitFor, &unmet)()
+	require.NoError(t, err)
+	assert.Equal(t, clusterWaitForMet, state)
+	assert.Empty(t, unmet)
+
+	_, state, err = resourceClusterWaitForRefreshFunc(context.Background(), c, clusterFailedUID, defaultClusterWaitFor, &unmet)()
+	require.NoError(t, err)
+	assert.Equal(t, "Provisioning", state, "the cluster's own state is reported until the conditions are met")
+	assert.Equal(t, []string{"cluster is Provisioning, not Running-Healthy"}, unmet)
+}
+
+func TestProbeKubeconfig(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" || r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"gitVersion":"v1.30.0"}`))
+	}))
+	defer srv.Close()
+
+	ca := base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	kubeconfig := func(token string) string {
+		return fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: admin@test
+clusters:
+- name: test
+  cluster:
+    server: %s
+    certificate-authority-data: %s
+contexts:
+- name: admin@test
+  context:
+    cluster: test
+    user: admin
+users:
+- name: admin
+  user:
+    token: %s
+`, srv.URL, ca, token)
+	}
+
+	assert.NoError(t, probeKubeconfig(context.Background(), kubeconfig("secret")))
+	assert.ErrorContains(t, probeKubeconfig(context.Background(), kubeconfig("wrong")), "401")
+	assert.EqualError(t, probeKubeconfig(context.Background(), "clusters: []"), "kubeconfig has no current context",
+		"the context is resolved the same way as by spectrocloud_cluster_kubeconfig")
+	assert.EqualError(t, probeKubeconfig(context.Background(), "contexts: [{name: only, context: {cluster: gone}}]"),
+		`kubeconfig has no server for context "only"`)
+}
@@ -260,6 +260,7 @@ func alignPackStateWithConfig(pack map[string]interface{}, configPack map[string
 	if _, ok := configPack["manifest"]; !ok {
 		delete(pack, "manifest")
 	}
+	alignPackValuesWithOverrides(pack, configPack, true)
 }
 
 // alignClusterProfilesStateWithConfig aligns refreshed profile state with config field presence.
@@ -100,9 +100,8 @@ func unitTestProviderConfigure(ctx context.Context) (interface{}, diag.Diagnosti
 	//client.WithTransportDebug()(c)
 
 	uid := projectUID
-	ProviderInitProjectUid = uid
 	client.WithScopeProject(uid)(c)
-	return c, diags
+	return newProviderMeta(c, uid), diags
 }
 
 func unitTestNegativeCaseProviderConfigure(ctx context.Context) (interface{}, diag.Diagnostics) {
@@ -123,9 +122,36 @@ func unitTestNegativeCaseProviderConfigure(ctx context.Context) (interface{}, di
 	//client.WithTransportDebug()(c)
 
 	uid := projectUID
-	ProviderInitProjectUid = uid
 	client.WithScopeProject(uid)(c)
-	return c, diags
+	return newProviderMeta(c, uid), diags
+}
+
+// withTestFeaturePreview returns a copy of the provider meta m with the
+// named feature_preview entries enabled. A nil m yields a bare meta, for
+// code paths that never reach the API.
+func withTestFeaturePreview(m interface{}, names ...string) interface{} {
+	meta := testMetaCopy(m)
+	meta.featurePreview = map[string]bool{}
+	for _, name := range names {
+		meta.featurePreview[name] = true
+	}
+	return meta
+}
+
+// withTestAddonDeploymentDisabled returns a copy of the provider meta m
+// with disable_addon_deployment_resource set to disabled.
+func withTestAddonDeploymentDisabled(m interface{}, disabled bool) interface{} {
+	meta := testMetaCopy(m)
+	meta.disableAddonDeploymentResource = disabled
+	return meta
+}
+
+func testMetaCopy(m interface{}) *providerMeta {
+	if m == nil {
+		return newProviderMeta(nil, projectUID)
+	}
+	meta := *m.(*providerMeta)
+	return &meta
 }
 
 func assertFirstDiagMessage(t *testing.T, diags diag.Diagnostics, msg string) {
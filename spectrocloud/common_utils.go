@@ -1,6 +1,8 @@
 package spectrocloud
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/spectrocloud/palette-sdk-go/client"
@@ -8,23 +10,41 @@ import (
 	"log"
 )
 
+// getV1ClientWithResourceContext returns a client scoped to resourceContext:
+// the tenant for "tenant", otherwise the project m is scoped to. Each call
+// returns its own copy of the provider's client, so resources in different
+// scopes can be applied concurrently without switching each other's scope.
+// Requests carry m's context, so they are logged against the resource that
+// made them.
 func getV1ClientWithResourceContext(m interface{}, resourceContext string) *client.V1Client {
-	c := m.(*client.V1Client)
-	switch resourceContext {
-	case "project":
-		if ProviderInitProjectUid != "" {
-			client.WithScopeProject(ProviderInitProjectUid)(c)
-		}
-		return c
-	case "tenant":
-		client.WithScopeTenant()(c)
-		return c
-	default:
-		if ProviderInitProjectUid != "" {
-			client.WithScopeProject(ProviderInitProjectUid)(c)
-		}
-		return c
+	meta := m.(*providerMeta)
+	c := *meta.client
+	client.WithContext(meta.ctx)(&c)
+	if scope, projectUID := resourceScope(m, resourceContext); scope == "project" {
+		client.WithScopeProject(projectUID)(&c)
+	} else {
+		client.WithScopeTenant()(&c)
+	}
+	return &c
+}
+
+// clusterScopeContext returns the context getV1ClientWithResourceContext
+// scopes its client with, for calls made on the raw API client.
+func clusterScopeContext(m interface{}, resourceContext string) context.Context {
+	scope, projectUID := resourceScope(m, resourceContext)
+	return client.ContextForScope(m.(*providerMeta).ctx, scope, projectUID)
+}
+
+// resourceScope returns the scope, and the project UID for the project
+// scope, of the requests made for a resource in resourceContext: the tenant
+// for the tenant context or a provider without a project, the provider's
+// project otherwise.
+func resourceScope(m interface{}, resourceContext string) (string, string) {
+	meta := m.(*providerMeta)
+	if resourceContext == "tenant" || meta.projectUID == "" {
+		return "tenant", ""
 	}
+	return "project", meta.projectUID
 }
 
 func handleReadError(d *schema.ResourceData, err error, diags diag.Diagnostics) diag.Diagnostics {
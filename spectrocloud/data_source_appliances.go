@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func dataSourceAppliances() *schema.Resource {
@@ -30,6 +32,7 @@ func dataSourceAppliances() *schema.Resource {
 				Description: "The context of the appliances. Allowed values are `project` or `tenant`. " +
 					"Defaults to `project`." + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.DataSourceProjectSchema(),
 			"tags": {
 				Type:        schema.TypeMap,
 				Description: "A list of tags to filter the appliances.",
@@ -58,6 +61,10 @@ func dataSourceAppliances() *schema.Resource {
 }
 
 func dataSourcesApplianceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	resourceContext := d.Get("context").(string)
 	var diags diag.Diagnostics
 	c := getV1ClientWithResourceContext(m, resourceContext)
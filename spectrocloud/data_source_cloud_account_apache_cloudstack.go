@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func dataSourceCloudAccountApacheCloudStack() *schema.Resource {
@@ -37,6 +39,7 @@ func dataSourceCloudAccountApacheCloudStack() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"", "project", "tenant"}, false),
 				Description:  "The context of the account. Allowed values are `project` or `tenant` or ``. ",
 			},
+			"project": schemas.DataSourceProjectSchema(),
 			"private_cloud_gateway_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -46,7 +49,11 @@ func dataSourceCloudAccountApacheCloudStack() *schema.Resource {
 	}
 }
 
-func dataSourceCloudAccountApacheCloudStackRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceCloudAccountApacheCloudStackRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, "")
 
 	var diags diag.Diagnostics
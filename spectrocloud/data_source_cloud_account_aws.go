@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func dataSourceCloudAccountAws() *schema.Resource {
@@ -37,6 +39,7 @@ func dataSourceCloudAccountAws() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"", "project", "tenant"}, false),
 				Description:  "The context of the cluster. Allowed values are `project` or `tenant` or ``. ",
 			},
+			"project": schemas.DataSourceProjectSchema(),
 			"depends": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -48,7 +51,11 @@ func dataSourceCloudAccountAws() *schema.Resource {
 	}
 }
 
-func dataSourceCloudAccountAwsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceCloudAccountAwsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, "")
 
 	// Warning or errors can be collected in a slice type
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func dataSourceCloudAccountAzure() *schema.Resource {
@@ -58,11 +60,16 @@ func dataSourceCloudAccountAzure() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"", "project", "tenant"}, false),
 				Description:  "The context of the cluster. Allowed values are `project` or `tenant` or ``. ",
 			},
+			"project": schemas.DataSourceProjectSchema(),
 		},
 	}
 }
 
-func dataSourceCloudAccountAzureRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceCloudAccountAzureRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, "")
 
 	// Warning or errors can be collected in a slice type
@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func dataSourceCluster() *schema.Resource {
@@ -40,6 +42,7 @@ func dataSourceCluster() *schema.Resource {
 				Description: "The context of the cluster. Allowed values are `project` or `tenant`. " +
 					"Defaults to `project`." + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.DataSourceProjectSchema(),
 			"virtual": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -65,8 +68,12 @@ func dataSourceCluster() *schema.Resource {
 	}
 }
 
-func dataSourceClusterRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 	var diags diag.Diagnostics
 	if name, okName := d.GetOk("name"); okName {
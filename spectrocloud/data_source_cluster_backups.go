@@ -0,0 +1,203 @@
+package spectrocloud
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+)
+
+func dataSourceClusterBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterBackupsRead,
+		Description: "Lists the backups of a cluster, both the scheduled backups of its `backup_policy` and on-demand backups, newest first.",
+
+		Schema: map[string]*schema.Schema{
+			"cluster_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UID of the cluster.",
+			},
+			"context": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "project",
+				ValidateFunc: validation.StringInSlice([]string{"project", "tenant"}, false),
+				Description: "The context of the cluster. Allowed values are `project` or `tenant`. " +
+					"Defaults to `project`." + PROJECT_NAME_NUANCE,
+			},
+			"project": schemas.DataSourceProjectSchema(),
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the backups whose name starts with this prefix, such as the `prefix` of the cluster's `backup_policy`.",
+			},
+			"created_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Only list the backups taken at or after this time, in RFC3339 format. Backups that have not been taken yet are left out.",
+			},
+			"created_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Only list the backups taken before this time, in RFC3339 format. Backups that have not been taken yet are left out.",
+			},
+			"backups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The backups of the cluster, newest first. Backups that have not been taken yet come last.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the backup.",
+						},
+						"request_uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The request UID of the backup, the `backup_request_uid` of a `spectrocloud_cluster_restore`.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the backup, such as `InProgress`, `Completed`, `PartiallyFailed` or `Failed`.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The message Palette reports for the state of the backup, if any.",
+						},
+						"backup_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the backup was taken, in RFC3339 format.",
+						},
+						"expiry_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the backup expires, in RFC3339 format.",
+						},
+						"namespaces": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The namespaces the backup contains.",
+						},
+						"backup_location_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the backup storage location the backup is stored in.",
+						},
+						"backup_location_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the backup storage location the backup is stored in.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceClusterBackupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	clusterUID := d.Get("cluster_uid").(string)
+	config, err := c.GetClusterBackupConfig(clusterUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Both bounds were validated by the schema.
+	var after, before time.Time
+	if v := d.Get("created_after").(string); v != "" {
+		after, _ = time.Parse(time.RFC3339, v)
+	}
+	if v := d.Get("created_before").(string); v != "" {
+		before, _ = time.Parse(time.RFC3339, v)
+	}
+
+	backups := filterClusterBackups(config, d.Get("prefix").(string), after, before)
+	if err := d.Set("backups", backups); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(clusterUID)
+	return nil
+}
+
+// filterClusterBackups flattens the backups of a cluster's backup feature
+// that match prefix and were taken within [after, before), newest first. A
+// zero bound is unbounded.
+func filterClusterBackups(config *models.V1ClusterBackup, prefix string, after, before time.Time) []interface{} {
+	type backup struct {
+		taken time.Time
+		data  map[string]interface{}
+	}
+	var found []backup
+	if config != nil && config.Status != nil {
+		for _, request := range config.Status.ClusterBackupStatuses {
+			if request == nil {
+				continue
+			}
+			locationID, locationName := "", ""
+			if request.BackupLocationConfig != nil {
+				locationID, locationName = request.BackupLocationConfig.UID, request.BackupLocationConfig.Name
+			}
+			for _, b := range request.BackupStatusMeta {
+				if b == nil || !strings.HasPrefix(b.BackupName, prefix) {
+					continue
+				}
+				var taken time.Time
+				msg := ""
+				if b.BackupState != nil {
+					taken = time.Time(b.BackupState.BackupTime)
+					msg = b.BackupState.Msg
+				}
+				if (!after.IsZero() || !before.IsZero()) && taken.IsZero() {
+					continue
+				}
+				if (!after.IsZero() && taken.Before(after)) || (!before.IsZero() && !taken.Before(before)) {
+					continue
+				}
+
+				namespaces := b.BackupedNamespaces
+				if namespaces == nil {
+					namespaces = []string{}
+				}
+				found = append(found, backup{taken, map[string]interface{}{
+					"name":                 b.BackupName,
+					"request_uid":          request.BackupRequestUID,
+					"state":                clusterBackupState(request, b),
+					"message":              msg,
+					"backup_time":          formatV1Time(models.V1Time(taken)),
+					"expiry_date":          formatV1Time(b.ExpiryDate),
+					"namespaces":           namespaces,
+					"backup_location_id":   locationID,
+					"backup_location_name": locationName,
+				}})
+			}
+		}
+	}
+
+	sort.SliceStable(found, func(i, j int) bool { return found[i].taken.After(found[j].taken) })
+	out := make([]interface{}, 0, len(found))
+	for _, b := range found {
+		out = append(out, b.data)
+	}
+	return out
+}
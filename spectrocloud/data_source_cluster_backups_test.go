@@ -0,0 +1,64 @@
+package spectrocloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readClusterBackupNames(t *testing.T, filters map[string]string) []string {
+	t.Helper()
+	d := dataSourceClusterBackups().TestResourceData()
+	_ = d.Set("cluster_uid", clusterBackupUID)
+	for k, v := range filters {
+		_ = d.Set(k, v)
+	}
+	require.Empty(t, dataSourceClusterBackupsRead(context.Background(), d, unitTestMockAPIClient))
+	assert.Equal(t, clusterBackupUID, d.Id())
+
+	var names []string
+	for _, b := range d.Get("backups").([]interface{}) {
+		names = append(names, b.(map[string]interface{})["name"].(string))
+	}
+	return names
+}
+
+func TestDataSourceClusterBackupsRead(t *testing.T) {
+	assert.Equal(t, []string{"pre-upgrade", "daily-app-20260930", "daily-app-20260929", "broken"}, readClusterBackupNames(t, nil),
+		"newest first, backups not taken yet last")
+	assert.Equal(t, []string{"daily-app-20260930", "daily-app-20260929"}, readClusterBackupNames(t, map[string]string{"prefix": "daily-app"}))
+	assert.Equal(t, []string{"pre-upgrade", "daily-app-20260930"}, readClusterBackupNames(t, map[string]string{"created_after": "2026-09-30T12:00:00Z"}),
+		"created_after is inclusive and leaves out backups not taken yet")
+	assert.Equal(t, []string{"daily-app-20260930", "daily-app-20260929"}, readClusterBackupNames(t, map[string]string{"created_before": "2026-10-01T12:00:00Z"}),
+		"created_before is exclusive")
+	assert.Empty(t, readClusterBackupNames(t, map[string]string{"prefix": "weekly"}))
+
+	d := dataSourceClusterBackups().TestResourceData()
+	_ = d.Set("cluster_uid", clusterBackupUID)
+	_ = d.Set("prefix", "daily-app-20260930")
+	require.Empty(t, dataSourceClusterBackupsRead(context.Background(), d, unitTestMockAPIClient))
+	assert.Equal(t, map[string]interface{}{
+		"name":                 "daily-app-20260930",
+		"request_uid":          "backup-schedule-request-uid",
+		"state":                "PartiallyFailed",
+		"message":              "1 volume snapshot failed",
+		"backup_time":          "2026-09-30T12:00:00Z",
+		"expiry_date":          "2026-10-01T12:00:00Z",
+		"namespaces":           []interface{}{"app"},
+		"backup_location_id":   "test-bsl-location-id",
+		"backup_location_name": "test-bsl",
+	}, d.Get("backups").([]interface{})[0])
+}
+
+func TestDataSourceClusterBackupsReadWithoutBackups(t *testing.T) {
+	d := dataSourceClusterBackups().TestResourceData()
+	_ = d.Set("cluster_uid", "test-cluster-id")
+	require.Empty(t, dataSourceClusterBackupsRead(context.Background(), d, unitTestMockAPIClient))
+	assert.Empty(t, d.Get("backups"))
+
+	d = dataSourceClusterBackups().TestResourceData()
+	_ = d.Set("cluster_uid", "cluster-uid-policy-error")
+	assert.NotEmpty(t, dataSourceClusterBackupsRead(context.Background(), d, unitTestMockAPIClient))
+}
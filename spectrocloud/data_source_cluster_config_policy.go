@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func dataSourceClusterConfigPolicy() *schema.Resource {
@@ -27,6 +29,7 @@ func dataSourceClusterConfigPolicy() *schema.Resource {
 				Description: "The context of the cluster config policy. Allowed values are `project` or `tenant`. " +
 					"Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.DataSourceProjectSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -65,6 +68,10 @@ func dataSourceClusterConfigPolicy() *schema.Resource {
 }
 
 func dataSourceClusterConfigPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
 	var diags diag.Diagnostics
 
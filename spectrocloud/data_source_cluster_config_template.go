@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func dataSourceClusterConfigTemplate() *schema.Resource {
@@ -26,6 +28,7 @@ func dataSourceClusterConfigTemplate() *schema.Resource {
 				Description: "The context of the cluster config template. Allowed values are `project` or `tenant`. " +
 					"Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.DataSourceProjectSchema(),
 			"description": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -131,6 +134,10 @@ func dataSourceClusterConfigTemplate() *schema.Resource {
 }
 
 func dataSourceClusterConfigTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
 	var diags diag.Diagnostics
 
@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func dataSourceClusterGroup() *schema.Resource {
@@ -28,12 +30,17 @@ func dataSourceClusterGroup() *schema.Resource {
 				Description: "The context of where the cluster group is located. " +
 					"Allowed values  are `system` or `tenant`. Defaults to `tenant`." + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.DataSourceProjectSchema(),
 		},
 	}
 }
 
-func dataSourceClusterGroupRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceClusterGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	GroupContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, GroupContext)
 	var diags diag.Diagnostics
 	if name, okName := d.GetOk("name"); okName {
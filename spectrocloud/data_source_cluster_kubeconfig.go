@@ -0,0 +1,346 @@
+package spectrocloud
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	clientv1 "github.com/spectrocloud/palette-sdk-go/api/client/version1"
+	"github.com/spectrocloud/palette-sdk-go/client"
+	"github.com/spectrocloud/palette-sdk-go/client/apiutil"
+	"github.com/spectrocloud/palette-sdk-go/client/herr"
+	"gopkg.in/yaml.v3"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+)
+
+// Kubeconfig variants Palette issues for a cluster.
+const (
+	clusterKubeconfigProxy  = "proxy"
+	clusterKubeconfigDirect = "direct"
+	clusterKubeconfigOIDC   = "oidc"
+	clusterKubeconfigAdmin  = "admin"
+)
+
+func dataSourceClusterKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterKubeconfigRead,
+		Description: "Fetches a kubeconfig of a cluster and returns its connection details as separate attributes, " +
+			"ready for the `kubernetes` and `helm` providers, along with the expiry of its certificates and token.",
+
+		Schema: map[string]*schema.Schema{
+			"cluster_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UID of the cluster.",
+			},
+			"context": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "project",
+				ValidateFunc: validation.StringInSlice([]string{"project", "tenant"}, false),
+				Description: "The context of the cluster. Allowed values are `project` or `tenant`. " +
+					"Defaults to `project`." + PROJECT_NAME_NUANCE,
+			},
+			"project": schemas.DataSourceProjectSchema(),
+			"variant": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      clusterKubeconfigProxy,
+				ValidateFunc: validation.StringInSlice([]string{clusterKubeconfigProxy, clusterKubeconfigDirect, clusterKubeconfigOIDC, clusterKubeconfigAdmin}, false),
+				Description: "The kubeconfig to fetch. `proxy` reaches the API server through Palette's reverse proxy when the cluster has one, " +
+					"and directly otherwise. `direct` always reaches the API server directly. `oidc` is the client kubeconfig, which " +
+					"signs users in with the cluster's OIDC identity provider, so their access is scoped by the cluster's RBAC bindings. " +
+					"`admin` carries cluster-admin credentials. Defaults to `proxy`.",
+			},
+			"kubeconfig_context": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "The kubeconfig context to return the connection details of. " +
+					"Defaults to the current context of the kubeconfig.",
+			},
+			"kubeconfig": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The raw kubeconfig.",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the API server.",
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM-encoded CA certificate of the API server.",
+			},
+			"insecure": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the kubeconfig skips the verification of the API server's certificate.",
+			},
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM-encoded client certificate, for kubeconfigs that authenticate with certificates.",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM-encoded client key, for kubeconfigs that authenticate with certificates.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The bearer token, for kubeconfigs that authenticate with a token, including the ID token of an OIDC `auth-provider`.",
+			},
+			"exec": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The credential plugin, for kubeconfigs that get their credentials from a command, such as `kubectl oidc-login`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The API version of the `ExecCredential` the command returns.",
+						},
+						"command": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The command to run.",
+						},
+						"args": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The arguments of the command.",
+						},
+						"env": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The environment variables of the command.",
+						},
+					},
+				},
+			},
+			"cluster_ca_certificate_expiry": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the CA certificate of the API server expires, in RFC3339 format.",
+			},
+			"client_certificate_expiry": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the client certificate expires, in RFC3339 format. Empty without a client certificate.",
+			},
+			"token_expiry": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The time the token expires, in RFC3339 format, read from its `exp` claim. " +
+					"Empty without a token, or when the token is not a JWT with an `exp` claim.",
+			},
+		},
+	}
+}
+
+func dataSourceClusterKubeconfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	clusterUID := d.Get("cluster_uid").(string)
+	variant := d.Get("variant").(string)
+	kubeconfig, err := getClusterKubeconfig(clusterScopeContext(m, d.Get("context").(string)), c, clusterUID, variant)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if kubeconfig == "" {
+		return diag.Errorf("cluster %s has no %s kubeconfig yet", clusterUID, variant)
+	}
+
+	conn, err := parseClusterKubeconfig(kubeconfig, d.Get("kubeconfig_context").(string))
+	if err != nil {
+		return diag.Errorf("%s kubeconfig of cluster %s: %s", variant, clusterUID, err)
+	}
+	for k, v := range conn {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("kubeconfig", kubeconfig); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(clusterUID)
+	return nil
+}
+
+// getClusterKubeconfig fetches the variant kubeconfig of a cluster. It
+// returns "" when Palette has not issued it. The client calls that the SDK
+// doesn't wrap are made with ctx, which must carry c's scope.
+func getClusterKubeconfig(ctx context.Context, c *client.V1Client, uid, variant string) (string, error) {
+	switch variant {
+	case clusterKubeconfigOIDC:
+		return c.GetClusterClientKubeConfig(uid)
+	case clusterKubeconfigAdmin:
+		return c.GetClusterAdminKubeConfig(uid)
+	case clusterKubeconfigDirect:
+		// GetClusterKubeConfig always asks for the reverse proxy.
+		builder := &strings.Builder{}
+		params := clientv1.NewV1SpectroClustersUIDKubeConfigParamsWithContext(ctx).
+			WithUID(uid).
+			WithFrp(BoolPtr(false))
+		if _, err := c.Client.V1SpectroClustersUIDKubeConfig(params, builder); err != nil {
+			if herr.IsNotFound(err) {
+				return "", nil
+			}
+			return "", err
+		}
+		kubeconfig := builder.String()
+		if apiutil.IsBase64(kubeconfig) {
+			bs, err := apiutil.Base64DecodeString(kubeconfig)
+			if err != nil {
+				return "", err
+			}
+			return string(bs), nil
+		}
+		return kubeconfig, nil
+	default:
+		return c.GetClusterKubeConfig(uid)
+	}
+}
+
+// parseClusterKubeconfig returns the attributes of the
+// spectrocloud_cluster_kubeconfig data source for the connection details of
+// a kubeconfig context, or of its current context when contextName is "".
+func parseClusterKubeconfig(kubeconfig, contextName string) (map[string]interface{}, error) {
+	var kc kubeconfigFile
+	if err := yaml.Unmarshal([]byte(kubeconfig), &kc); err != nil {
+		return nil, fmt.Errorf("parsing kubeconfig: %w", err)
+	}
+
+	contextName, cluster, user, err := kc.resolveContext(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := map[string]interface{}{
+		"kubeconfig_context":            contextName,
+		"host":                          cluster.Cluster.Server,
+		"cluster_ca_certificate":        "",
+		"insecure":                      cluster.Cluster.InsecureSkipTLSVerify,
+		"client_certificate":            "",
+		"client_key":                    "",
+		"token":                         "",
+		"exec":                          []interface{}{},
+		"cluster_ca_certificate_expiry": "",
+		"client_certificate_expiry":     "",
+		"token_expiry":                  "",
+	}
+	if cluster.Cluster.CertificateAuthorityData != "" {
+		ca, expiry, err := decodeKubeconfigCertificate(cluster.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("certificate-authority-data: %w", err)
+		}
+		attrs["cluster_ca_certificate"], attrs["cluster_ca_certificate_expiry"] = ca, expiry
+	}
+
+	if user != nil {
+		if user.User.ClientCertificateData != "" {
+			cert, expiry, err := decodeKubeconfigCertificate(user.User.ClientCertificateData)
+			if err != nil {
+				return nil, fmt.Errorf("client-certificate-data: %w", err)
+			}
+			attrs["client_certificate"], attrs["client_certificate_expiry"] = cert, expiry
+		}
+		if user.User.ClientKeyData != "" {
+			key, err := base64.StdEncoding.DecodeString(user.User.ClientKeyData)
+			if err != nil {
+				return nil, fmt.Errorf("decoding client-key-data: %w", err)
+			}
+			attrs["client_key"] = string(key)
+		}
+
+		token := user.User.Token
+		if token == "" && user.User.AuthProvider != nil {
+			token = user.User.AuthProvider.Config["id-token"]
+		}
+		attrs["token"], attrs["token_expiry"] = token, jwtExpiry(token)
+
+		if e := user.User.Exec; e != nil {
+			env := map[string]interface{}{}
+			for _, v := range e.Env {
+				env[v.Name] = v.Value
+			}
+			args := e.Args
+			if args == nil {
+				args = []string{}
+			}
+			attrs["exec"] = []interface{}{map[string]interface{}{
+				"api_version": e.APIVersion,
+				"command":     e.Command,
+				"args":        args,
+				"env":         env,
+			}}
+		}
+	}
+	return attrs, nil
+}
+
+// decodeKubeconfigCertificate decodes base64 certificate data of a
+// kubeconfig into PEM, and returns when its first certificate expires.
+func decodeKubeconfigCertificate(data string) (string, string, error) {
+	certPEM, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", "", fmt.Errorf("decoding: %w", err)
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return "", "", errors.New("no PEM certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", "", fmt.Errorf("parsing certificate: %w", err)
+	}
+	return string(certPEM), cert.NotAfter.UTC().Format(time.RFC3339), nil
+}
+
+// jwtExpiry returns the exp claim of token in RFC3339, or "" when token is
+// not a JWT with an exp claim. The token is not verified.
+func jwtExpiry(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Exp *json.Number `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == nil {
+		return ""
+	}
+	exp, err := claims.Exp.Float64()
+	if err != nil {
+		return ""
+	}
+	return time.Unix(int64(exp), 0).UTC().Format(time.RFC3339)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
 	"github.com/spectrocloud/palette-sdk-go/client"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func dataSourceClusterProfile() *schema.Resource {
@@ -38,6 +40,8 @@ func dataSourceClusterProfile() *schema.Resource {
 				Computed:    true,
 				Description: "The version of the cluster profile.",
 			},
+			"version_constraint": versionConstraintSchema("cluster profile", "version", "id"),
+			"matching_versions":  matchingVersionsSchema("cluster profile"),
 			"context": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -46,6 +50,7 @@ func dataSourceClusterProfile() *schema.Resource {
 				Description: "Cluster profile context. Allowed values are `project` or `tenant`. " +
 					"Defaults to `project`." + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.DataSourceProjectSchema(),
 			"pack": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -120,7 +125,11 @@ func dataSourceClusterProfile() *schema.Resource {
 	}
 }
 
-func dataSourceClusterProfileRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceClusterProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	ProjectContext := "project"
 	if Pcontext, ok_context := d.GetOk("context"); ok_context {
 		ProjectContext = Pcontext.(string)
@@ -139,7 +148,34 @@ func dataSourceClusterProfileRead(_ context.Context, d *schema.ResourceData, m i
 		version = ver.(string)
 	}
 
-	profile, err := getProfile(profiles, d, version, ProjectContext, c)
+	candidates := []string{version}
+	matched, constrained, err := resolveVersionConstraint(d, clusterProfileVersions(profiles, d.Get("name").(string)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if constrained {
+		if len(matched) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to find cluster profile",
+				Detail:   fmt.Sprintf("No version of cluster profile %s satisfies the version constraint %q", d.Get("name").(string), d.Get("version_constraint").(string)),
+			})
+			return diags
+		}
+		candidates = matched
+	}
+	if err := d.Set("matching_versions", matched); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Matching versions can belong to other contexts; take the highest one
+	// in this context.
+	var profile *models.V1ClusterProfile
+	for _, version = range candidates {
+		if profile, err = getProfile(profiles, d, version, ProjectContext, c); err == nil {
+			break
+		}
+	}
 	if err != nil {
 		return handleReadError(d, err, diags)
 	}
@@ -201,6 +237,23 @@ func GetDiagPacks(d *schema.ResourceData, err error) ([]*models.V1PackManifestEn
 	return diagPacks, nil, false
 }
 
+// clusterProfileVersions lists the versions of the cluster profiles named
+// name. Profiles without a version are version 1.0.0.
+func clusterProfileVersions(profiles []*models.V1ClusterProfileMetadata, name string) []string {
+	var versions []string
+	for _, p := range profiles {
+		if p == nil || p.Metadata == nil || p.Metadata.Name != name {
+			continue
+		}
+		version := "1.0.0"
+		if p.Spec != nil && p.Spec.Version != "" {
+			version = p.Spec.Version
+		}
+		versions = append(versions, version)
+	}
+	return versions
+}
+
 func getProfile(profiles []*models.V1ClusterProfileMetadata, d *schema.ResourceData, version, ProfileContext string, c *client.V1Client) (*models.V1ClusterProfile, error) {
 	for _, p := range profiles {
 		if v, ok := d.GetOk("id"); ok && v.(string) == p.Metadata.UID {
@@ -0,0 +1,464 @@
+package spectrocloud
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/palette-sdk-go/client"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+)
+
+func dataSourceClusterProfileDiff() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterProfileDiffRead,
+		Description: "Compares a cluster profile version with another version of the profile, or with the version a cluster currently runs, " +
+			"and reports the added, removed and changed packs together with a unified diff of their values and manifests.",
+
+		Schema: map[string]*schema.Schema{
+			"to_profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the cluster profile version to compare against, typically the version about to be rolled out.",
+			},
+			"from_profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"from_profile_id", "cluster_id"},
+				Description:  "The ID of the cluster profile version to compare from. Conflicts with `cluster_id`.",
+			},
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"from_profile_id", "cluster_id"},
+				Description: "The ID of a cluster to compare from. The cluster's attached version of the profile, including its cluster-level pack values, " +
+					"is compared with `to_profile_id`. The attached profile is matched by ID first and by name otherwise. Conflicts with `from_profile_id`.",
+			},
+			"context": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "project",
+				ValidateFunc: validation.StringInSlice([]string{"", "project", "tenant", "system"}, false),
+				Description:  "The context of the profiles and cluster. Allowed values are `project`, `tenant` or `system`. Defaults to `project`." + PROJECT_NAME_NUANCE,
+			},
+			"project": schemas.DataSourceProjectSchema(),
+			"from_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the profile compared from.",
+			},
+			"to_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the profile compared against.",
+			},
+			"has_changes": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether any pack was added, removed or changed.",
+			},
+			"added_packs":   profileDiffPackListSchema("Packs present only in `to_profile_id`."),
+			"removed_packs": profileDiffPackListSchema("Packs present only on the side compared from."),
+			"changed_packs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Packs present on both sides whose tag, type, registry, values or manifests differ.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the pack.",
+						},
+						"from_tag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The pack tag compared from.",
+						},
+						"to_tag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The pack tag compared against.",
+						},
+						"tag_changed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the pack tag changed.",
+						},
+						"values_changed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the pack values changed once normalised.",
+						},
+						"manifests_changed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether a manifest of the pack was added, removed or changed.",
+						},
+						"values_diff": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A unified diff of the pack values.",
+						},
+						"manifest_diff": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A unified diff of the pack manifests.",
+						},
+					},
+				},
+			},
+			"values_diff": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "A unified diff of the values of every added, removed and changed pack. " +
+					"Values are normalised YAML, so key order and formatting alone do not show up.",
+			},
+			"manifest_diff": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A unified diff of the manifests of every added, removed and changed pack, normalised like `values_diff`.",
+			},
+		},
+	}
+}
+
+func profileDiffPackListSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the pack.",
+				},
+				"tag": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The tag of the pack.",
+				},
+			},
+		},
+	}
+}
+
+func dataSourceClusterProfileDiffRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	toUID := d.Get("to_profile_id").(string)
+	to, err := clusterProfileDiffSideFromProfile(c, toUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var from profileDiffSide
+	var fromID string
+	if clusterUID, ok := d.GetOk("cluster_id"); ok {
+		fromID = "cluster/" + clusterUID.(string)
+		from, err = clusterProfileDiffSideFromCluster(c, clusterUID.(string), toUID, to.name)
+	} else {
+		fromID = d.Get("from_profile_id").(string)
+		from, err = clusterProfileDiffSideFromProfile(c, fromID)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diff := diffClusterProfilePacks(from, to)
+
+	d.SetId(fmt.Sprintf("%s:%s", fromID, toUID))
+	for k, v := range map[string]interface{}{
+		"from_version":  from.version,
+		"to_version":    to.version,
+		"has_changes":   diff.hasChanges(),
+		"added_packs":   diff.added,
+		"removed_packs": diff.removed,
+		"changed_packs": diff.changed,
+		"values_diff":   diff.valuesDiff,
+		"manifest_diff": diff.manifestDiff,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// profileDiffSide is one side of a profile diff: the published packs of a
+// profile version, or the packs of the profile version attached to a
+// cluster.
+type profileDiffSide struct {
+	name    string
+	version string
+	// packs are in canonicalizePackElement form, with values and manifest
+	// content passed through NormalizeYamlContent.
+	packs []map[string]interface{}
+}
+
+// label prefixes the file names in the unified diffs.
+func (s profileDiffSide) label() string {
+	return fmt.Sprintf("%s@%s", s.name, s.version)
+}
+
+func clusterProfileDiffSideFromProfile(c *client.V1Client, uid string) (profileDiffSide, error) {
+	profile, err := c.GetClusterProfile(uid)
+	if err != nil {
+		return profileDiffSide{}, err
+	}
+	if profile == nil || profile.Metadata == nil || profile.Spec == nil || profile.Spec.Published == nil {
+		return profileDiffSide{}, fmt.Errorf("cluster profile %s not found", uid)
+	}
+	packs, err := profileDiffPacks(c, uid, profile.Spec.Published.Packs)
+	if err != nil {
+		return profileDiffSide{}, err
+	}
+	return profileDiffSide{name: profile.Metadata.Name, version: profile.Spec.Version, packs: packs}, nil
+}
+
+// clusterProfileDiffSideFromCluster returns the version of a profile a
+// cluster runs: the attached profile with UID profileUID, or else the one
+// named profileName. Pack values come from the cluster, so they include its
+// overrides; manifest content comes from the attached profile.
+func clusterProfileDiffSideFromCluster(c *client.V1Client, clusterUID, profileUID, profileName string) (profileDiffSide, error) {
+	cluster, err := c.GetCluster(clusterUID)
+	if err != nil {
+		return profileDiffSide{}, err
+	}
+	if cluster == nil || cluster.Spec == nil {
+		return profileDiffSide{}, fmt.Errorf("cluster %s not found", clusterUID)
+	}
+
+	var template *models.V1ClusterProfileTemplate
+	for _, t := range cluster.Spec.ClusterProfileTemplates {
+		if t != nil && t.UID == profileUID {
+			template = t
+			break
+		}
+	}
+	if template == nil {
+		for _, t := range cluster.Spec.ClusterProfileTemplates {
+			if t != nil && t.Name == profileName {
+				template = t
+				break
+			}
+		}
+	}
+	if template == nil {
+		return profileDiffSide{}, fmt.Errorf("cluster %s has no version of cluster profile %s attached", clusterUID, profileName)
+	}
+
+	packs, err := profileDiffPacks(c, template.UID, template.Packs)
+	if err != nil {
+		return profileDiffSide{}, err
+	}
+	return profileDiffSide{name: template.Name, version: template.ProfileVersion, packs: packs}, nil
+}
+
+// profileDiffPacks reads packs into the form the cluster profile resource
+// compares packs in, fetching the content of manifest packs from profileUID.
+func profileDiffPacks(c *client.V1Client, profileUID string, packs []*models.V1PackRef) ([]map[string]interface{}, error) {
+	raw := make([]interface{}, 0, len(packs))
+	for _, p := range packs {
+		if p == nil || p.Name == nil {
+			continue
+		}
+		manifests := make([]interface{}, 0, len(p.Manifests))
+		if len(p.Manifests) > 0 {
+			content, err := c.GetClusterProfileManifestPack(profileUID, *p.Name)
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range content {
+				if m == nil || m.Metadata == nil || m.Spec == nil || m.Spec.Published == nil {
+					continue
+				}
+				manifests = append(manifests, map[string]interface{}{
+					"name":    m.Metadata.Name,
+					"content": m.Spec.Published.Content,
+				})
+			}
+		}
+		tag := p.Tag
+		if tag == "" {
+			tag = p.Version
+		}
+		raw = append(raw, map[string]interface{}{
+			"name":         *p.Name,
+			"tag":          tag,
+			"type":         p.Type,
+			"values":       p.Values,
+			"registry_uid": p.RegistryUID,
+			"manifest":     manifests,
+		})
+	}
+
+	out := canonicalizePackList(raw)
+	for _, p := range out {
+		p["values"] = NormalizeYamlContent(p["values"].(string))
+		manifests := p["manifest"].([]map[string]interface{})
+		for _, m := range manifests {
+			m["content"] = NormalizeYamlContent(m["content"].(string))
+		}
+		sort.Slice(manifests, func(i, j int) bool { return manifests[i]["name"].(string) < manifests[j]["name"].(string) })
+	}
+	return out, nil
+}
+
+type clusterProfilePackDiff struct {
+	added        []interface{}
+	removed      []interface{}
+	changed      []interface{}
+	valuesDiff   string
+	manifestDiff string
+}
+
+func (diff clusterProfilePackDiff) hasChanges() bool {
+	return len(diff.added)+len(diff.removed)+len(diff.changed) > 0
+}
+
+// diffClusterProfilePacks matches packs by name. Packs are reported in the
+// order of to, with removed packs in the order of from.
+func diffClusterProfilePacks(from, to profileDiffSide) clusterProfilePackDiff {
+	diff := clusterProfilePackDiff{
+		added:   make([]interface{}, 0),
+		removed: make([]interface{}, 0),
+		changed: make([]interface{}, 0),
+	}
+	fromByName := make(map[string]map[string]interface{}, len(from.packs))
+	for _, p := range from.packs {
+		fromByName[p["name"].(string)] = p
+	}
+	toByName := make(map[string]bool, len(to.packs))
+
+	var valuesDiffs, manifestDiffs []string
+	addDiffs := func(name string, fromPack, toPack map[string]interface{}) (string, string) {
+		values := unifiedYAMLDiff(from.label()+"/"+name+"/values.yaml", to.label()+"/"+name+"/values.yaml",
+			packString(fromPack, "values"), packString(toPack, "values"))
+		manifests := diffPackManifests(from.label()+"/"+name, to.label()+"/"+name, fromPack, toPack)
+		if values != "" {
+			valuesDiffs = append(valuesDiffs, values)
+		}
+		if manifests != "" {
+			manifestDiffs = append(manifestDiffs, manifests)
+		}
+		return values, manifests
+	}
+
+	for _, toPack := range to.packs {
+		name := toPack["name"].(string)
+		toByName[name] = true
+		fromPack, ok := fromByName[name]
+		if !ok {
+			diff.added = append(diff.added, map[string]interface{}{"name": name, "tag": toPack["tag"]})
+			addDiffs(name, nil, toPack)
+			continue
+		}
+		if reflect.DeepEqual(fromPack, toPack) {
+			continue
+		}
+		valuesDiff, manifestDiff := addDiffs(name, fromPack, toPack)
+		diff.changed = append(diff.changed, map[string]interface{}{
+			"name":              name,
+			"from_tag":          fromPack["tag"],
+			"to_tag":            toPack["tag"],
+			"tag_changed":       fromPack["tag"] != toPack["tag"],
+			"values_changed":    fromPack["values"] != toPack["values"],
+			"manifests_changed": !reflect.DeepEqual(fromPack["manifest"], toPack["manifest"]),
+			"values_diff":       valuesDiff,
+			"manifest_diff":     manifestDiff,
+		})
+	}
+	for _, fromPack := range from.packs {
+		name := fromPack["name"].(string)
+		if toByName[name] {
+			continue
+		}
+		diff.removed = append(diff.removed, map[string]interface{}{"name": name, "tag": fromPack["tag"]})
+		addDiffs(name, fromPack, nil)
+	}
+
+	diff.valuesDiff = strings.Join(valuesDiffs, "")
+	diff.manifestDiff = strings.Join(manifestDiffs, "")
+	return diff
+}
+
+// diffPackManifests diffs the manifests of two packs by manifest name. A nil
+// pack has no manifests.
+func diffPackManifests(fromPrefix, toPrefix string, fromPack, toPack map[string]interface{}) string {
+	content := func(p map[string]interface{}) map[string]string {
+		out := map[string]string{}
+		if p == nil {
+			return out
+		}
+		for _, m := range p["manifest"].([]map[string]interface{}) {
+			out[m["name"].(string)] = m["content"].(string)
+		}
+		return out
+	}
+	fromContent, toContent := content(fromPack), content(toPack)
+
+	names := make([]string, 0, len(fromContent)+len(toContent))
+	for name := range fromContent {
+		names = append(names, name)
+	}
+	for name := range toContent {
+		if _, ok := fromContent[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(unifiedYAMLDiff(fromPrefix+"/manifests/"+name+".yaml", toPrefix+"/manifests/"+name+".yaml",
+			fromContent[name], toContent[name]))
+	}
+	return b.String()
+}
+
+func packString(p map[string]interface{}, key string) string {
+	if p == nil {
+		return ""
+	}
+	return p[key].(string)
+}
+
+// unifiedYAMLDiff returns a unified diff of two YAML documents, or "" when
+// they are equal.
+func unifiedYAMLDiff(fromFile, toFile, from, to string) string {
+	if from == to {
+		return ""
+	}
+	lines := func(s string) []string {
+		if s == "" {
+			return nil
+		}
+		return difflib.SplitLines(s)
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        lines(from),
+		B:        lines(to),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+	if err != nil {
+		return ""
+	}
+	return diff
+}
@@ -0,0 +1,125 @@
+package spectrocloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The diff fixtures live in mockClusterProfile.go and mockCluster.go.
+const (
+	clusterProfileDiffUID        = "cluster-profile-diff-v2"
+	clusterProfileDiffClusterUID = "cluster-uid-profile-diff"
+)
+
+func TestDiffClusterProfilePacks(t *testing.T) {
+	side := func(version string, packs ...map[string]interface{}) profileDiffSide {
+		return profileDiffSide{name: "infra", version: version, packs: packs}
+	}
+	pack := func(name, tag, values string, manifests ...map[string]interface{}) map[string]interface{} {
+		if manifests == nil {
+			manifests = []map[string]interface{}{}
+		}
+		return map[string]interface{}{"name": name, "tag": tag, "type": "spectro", "values": values, "manifest": manifests}
+	}
+
+	same := side("1.0.0", pack("k8", "1.27.0", "a: 1"))
+	diff := diffClusterProfilePacks(same, same)
+	assert.False(t, diff.hasChanges())
+	assert.Empty(t, diff.valuesDiff)
+
+	from := side("1.0.0",
+		pack("k8", "1.27.0", "a: 1\nb: 2"),
+		pack("cni", "3.26.0", "mode: vxlan"),
+		pack("app", "1.0.0", "", map[string]interface{}{"name": "cm", "content": "x: 1"}),
+	)
+	to := side("1.1.0",
+		pack("k8", "1.28.0", "a: 1\nb: 3"),
+		pack("app", "1.0.0", "", map[string]interface{}{"name": "cm", "content": "x: 2"}),
+		pack("csi", "1.20.0", "gp3: true"),
+	)
+	diff = diffClusterProfilePacks(from, to)
+	assert.True(t, diff.hasChanges())
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "csi", "tag": "1.20.0"}}, diff.added)
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "cni", "tag": "3.26.0"}}, diff.removed)
+	require.Len(t, diff.changed, 2)
+
+	k8 := diff.changed[0].(map[string]interface{})
+	assert.Equal(t, "k8", k8["name"])
+	assert.Equal(t, true, k8["tag_changed"])
+	assert.Equal(t, true, k8["values_changed"])
+	assert.Equal(t, false, k8["manifests_changed"])
+	assert.Equal(t, "--- infra@1.0.0/k8/values.yaml\n+++ infra@1.1.0/k8/values.yaml\n@@ -1,2 +1,2 @@\n a: 1\n-b: 2\n+b: 3\n", k8["values_diff"])
+
+	app := diff.changed[1].(map[string]interface{})
+	assert.Equal(t, false, app["tag_changed"])
+	assert.Equal(t, true, app["manifests_changed"])
+	assert.Equal(t, "--- infra@1.0.0/app/manifests/cm.yaml\n+++ infra@1.1.0/app/manifests/cm.yaml\n@@ -1 +1 @@\n-x: 1\n+x: 2\n", app["manifest_diff"])
+
+	assert.Contains(t, diff.valuesDiff, "+++ infra@1.1.0/csi/values.yaml\n@@ -0,0 +1 @@\n+gp3: true\n")
+	assert.Contains(t, diff.valuesDiff, "--- infra@1.0.0/cni/values.yaml\n")
+	assert.Equal(t, app["manifest_diff"], diff.manifestDiff)
+}
+
+func TestProfileDiffPacksNormalisesValues(t *testing.T) {
+	c := castV1Client(t, unitTestMockAPIClient)
+
+	from, err := clusterProfileDiffSideFromCluster(c, clusterProfileDiffClusterUID, clusterProfileDiffUID, "test-cluster-profile-1")
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0", from.version)
+	to, err := clusterProfileDiffSideFromProfile(c, clusterProfileDiffUID)
+	require.NoError(t, err)
+	assert.Equal(t, "1.1.0", to.version)
+
+	// The k8 values only differ in key order and the image tag.
+	diff := diffClusterProfilePacks(from, to)
+	k8 := diff.changed[0].(map[string]interface{})
+	assert.Equal(t, "--- test-cluster-profile-1@1.0.0/k8/values.yaml\n+++ test-cluster-profile-1@1.1.0/k8/values.yaml\n"+
+		"@@ -1,2 +1,2 @@\n-image: k8s:1.27\n+image: k8s:1.28\n replicas: 1\n", k8["values_diff"])
+
+	_, err = clusterProfileDiffSideFromCluster(c, clusterProfileDiffClusterUID, "no-such-uid", "test-cluster-profile-1")
+	require.NoError(t, err, "the profile is matched by name when the cluster runs another version")
+	_, err = clusterProfileDiffSideFromCluster(c, "test-cluster-id", "no-such-uid", "no-such-profile")
+	assert.EqualError(t, err, "cluster test-cluster-id has no version of cluster profile no-such-profile attached")
+}
+
+func TestDataSourceClusterProfileDiffRead(t *testing.T) {
+	d := dataSourceClusterProfileDiff().TestResourceData()
+	_ = d.Set("cluster_id", clusterProfileDiffClusterUID)
+	_ = d.Set("to_profile_id", clusterProfileDiffUID)
+	diags := dataSourceClusterProfileDiffRead(context.Background(), d, unitTestMockAPIClient)
+	require.Empty(t, diags)
+
+	assert.Equal(t, "cluster/"+clusterProfileDiffClusterUID+":"+clusterProfileDiffUID, d.Id())
+	assert.Equal(t, "1.0.0", d.Get("from_version"))
+	assert.Equal(t, "1.1.0", d.Get("to_version"))
+	assert.Equal(t, true, d.Get("has_changes"))
+	assert.Equal(t, "csi-aws-ebs", d.Get("added_packs.0.name"))
+	assert.Equal(t, "cni-calico", d.Get("removed_packs.0.name"))
+	assert.Equal(t, 2, d.Get("changed_packs.#"))
+	assert.Equal(t, "1.27.0", d.Get("changed_packs.0.from_tag"))
+	assert.Equal(t, "1.28.0", d.Get("changed_packs.0.to_tag"))
+	assert.Equal(t, "custom-manifests", d.Get("changed_packs.1.name"))
+	assert.Equal(t, true, d.Get("changed_packs.1.manifests_changed"))
+	assert.Contains(t, d.Get("manifest_diff"), "-test-content\n+kind: ConfigMap\n")
+
+	d = dataSourceClusterProfileDiff().TestResourceData()
+	_ = d.Set("from_profile_id", clusterProfileDiffUID)
+	_ = d.Set("to_profile_id", clusterProfileDiffUID)
+	diags = dataSourceClusterProfileDiffRead(context.Background(), d, unitTestMockAPIClient)
+	require.Empty(t, diags)
+	assert.Equal(t, false, d.Get("has_changes"))
+	assert.Empty(t, d.Get("values_diff"))
+}
+
+func TestDataSourceClusterProfileDiffReadTenantProject(t *testing.T) {
+	d := dataSourceClusterProfileDiff().TestResourceData()
+	_ = d.Set("from_profile_id", clusterProfileDiffUID)
+	_ = d.Set("to_profile_id", clusterProfileDiffUID)
+	_ = d.Set("context", "tenant")
+	_ = d.Set("project", "Default")
+	diags := dataSourceClusterProfileDiffRead(context.Background(), d, unitTestMockAPIClient)
+	assertFirstDiagMessage(t, diags, `project "Default" cannot be set when context is "tenant"`)
+}
@@ -0,0 +1,96 @@
+package spectrocloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+)
+
+func dataSourceClusterProfileExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterProfileExportRead,
+		Description: "Exports a cluster profile version in Palette's import file format, including its packs, manifests and variables. " +
+			"Write `content` to a file to import the profile elsewhere with `spectrocloud_cluster_profile_import`.",
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the cluster profile version to export.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "json",
+				ValidateFunc: validation.StringInSlice([]string{"json", "yaml"}, false),
+				Description:  "The format of the export. Allowed values are `json` or `yaml`. Defaults to `json`, the format Palette's profile import expects.",
+			},
+			"context": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "project",
+				ValidateFunc: validation.StringInSlice([]string{"", "project", "tenant", "system"}, false),
+				Description:  "The context of the cluster profile. Allowed values are `project`, `tenant` or `system`. Defaults to `project`." + PROJECT_NAME_NUANCE,
+			},
+			"project": schemas.DataSourceProjectSchema(),
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the exported cluster profile.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the exported cluster profile.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The exported cluster profile.",
+			},
+		},
+	}
+}
+
+func dataSourceClusterProfileExportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	uid := d.Get("profile_id").(string)
+	profile, err := c.GetClusterProfile(uid)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if profile == nil || profile.Metadata == nil || profile.Spec == nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to find cluster profile",
+			Detail:   fmt.Sprintf("Unable to find the cluster profile with ID %s", uid),
+		}}
+	}
+
+	content, err := c.ExportClusterProfile(uid, d.Get("format").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("exporting cluster profile %s: %w", uid, err))
+	}
+
+	d.SetId(uid)
+	if err := d.Set("name", profile.Metadata.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("version", profile.Spec.Version); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("content", content.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
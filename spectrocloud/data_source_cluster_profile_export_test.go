@@ -0,0 +1,47 @@
+package spectrocloud
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceClusterProfileExportRead(t *testing.T) {
+	d := dataSourceClusterProfileExport().TestResourceData()
+	_ = d.Set("profile_id", "cluster-profile-import-1")
+	diags := dataSourceClusterProfileExportRead(context.Background(), d, unitTestMockAPIClient)
+	require.Empty(t, diags)
+
+	assert.Equal(t, "cluster-profile-import-1", d.Id())
+	assert.Equal(t, "test-cluster-profile-1", d.Get("name"))
+	assert.Equal(t, "1.0.0", d.Get("version"))
+	var export map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(d.Get("content").(string)), &export))
+	assert.Contains(t, export["spec"], "variables")
+
+	d = dataSourceClusterProfileExport().TestResourceData()
+	_ = d.Set("profile_id", "cluster-profile-import-1")
+	_ = d.Set("format", "yaml")
+	diags = dataSourceClusterProfileExportRead(context.Background(), d, unitTestMockAPIClient)
+	require.Empty(t, diags)
+	assert.Equal(t, "metadata:\n  name: test-cluster-profile-1\nspec:\n  version: 1.0.0\n", d.Get("content"))
+}
+
+func TestDataSourceClusterProfileExportReadNegative(t *testing.T) {
+	d := dataSourceClusterProfileExport().TestResourceData()
+	_ = d.Set("profile_id", "cluster-profile-import-1")
+	diags := dataSourceClusterProfileExportRead(context.Background(), d, unitTestMockAPINegativeClient)
+	assert.True(t, diags.HasError())
+}
+
+func TestDataSourceClusterProfileExportReadTenantProject(t *testing.T) {
+	d := dataSourceClusterProfileExport().TestResourceData()
+	_ = d.Set("profile_id", "cluster-profile-import-1")
+	_ = d.Set("context", "tenant")
+	_ = d.Set("project", "Default")
+	diags := dataSourceClusterProfileExportRead(context.Background(), d, unitTestMockAPIClient)
+	assertFirstDiagMessage(t, diags, `project "Default" cannot be set when context is "tenant"`)
+}
@@ -83,3 +83,20 @@ func TestReadClusterProfileFuncNameNegative(t *testing.T) {
 	diags = dataSourceClusterProfileRead(ctx, d, unitTestMockAPINegativeClient)
 	assertFirstDiagMessage(t, diags, "cluster profile not found")
 }
+
+func TestReadClusterProfileFuncVersionConstraint(t *testing.T) {
+	d := prepareBaseDataSourceClusterProfileSchema()
+	_ = d.Set("context", "project")
+	_ = d.Set("name", "test-cluster-profile-1")
+	_ = d.Set("version_constraint", "~> 1.0")
+	diags := dataSourceClusterProfileRead(context.Background(), d, unitTestMockAPIClient)
+	assert.Equal(t, 0, len(diags))
+	assert.Equal(t, []interface{}{"1.0.0"}, d.Get("matching_versions"))
+
+	d = prepareBaseDataSourceClusterProfileSchema()
+	_ = d.Set("context", "project")
+	_ = d.Set("name", "test-cluster-profile-1")
+	_ = d.Set("version_constraint", ">= 9.0")
+	diags = dataSourceClusterProfileRead(context.Background(), d, unitTestMockAPIClient)
+	assert.True(t, diags.HasError())
+}
@@ -0,0 +1,64 @@
+package spectrocloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+)
+
+func dataSourceClusterScanResults() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterScanResultsRead,
+		Description: "Returns the latest compliance scan report of a cluster for each scan type, whether the scan was scheduled " +
+			"by the cluster's `scan_policy` or run on demand by a `spectrocloud_cluster_scan`.",
+
+		Schema: map[string]*schema.Schema{
+			"cluster_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UID of the cluster.",
+			},
+			"context": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "project",
+				ValidateFunc: validation.StringInSlice([]string{"project", "tenant"}, false),
+				Description: "The context of the cluster. Allowed values are `project` or `tenant`. " +
+					"Defaults to `project`." + PROJECT_NAME_NUANCE,
+			},
+			"project": schemas.DataSourceProjectSchema(),
+			"reports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "The latest report of each scan type, in the order `configuration`, `penetration`, `conformance`. " +
+					"Scan types that never ran on the cluster are left out.",
+				Elem: clusterScanReportSchema(),
+			},
+		},
+	}
+}
+
+func dataSourceClusterScanResultsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	clusterUID := d.Get("cluster_uid").(string)
+	logs, err := c.GetComplianceScanOnDemandScanLogs(clusterUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	reports := flattenClusterScanReports(latestClusterScanReports(logs), clusterScanTypes)
+	if err := d.Set("reports", reports); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(clusterUID)
+	return nil
+}
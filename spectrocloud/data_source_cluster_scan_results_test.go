@@ -0,0 +1,65 @@
+package spectrocloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceClusterScanResultsRead(t *testing.T) {
+	d := dataSourceClusterScanResults().TestResourceData()
+	_ = d.Set("cluster_uid", "cluster-uid-scan")
+	require.Empty(t, dataSourceClusterScanResultsRead(context.Background(), d, unitTestMockAPIClient))
+	assert.Equal(t, "cluster-uid-scan", d.Id())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"type":          "configuration",
+			"request_uid":   "kube-bench-request-new",
+			"state":         "Completed",
+			"message":       "",
+			"start_time":    "2026-10-01T02:00:00Z",
+			"end_time":      "2026-10-01T02:10:00Z",
+			"pass":          60,
+			"fail":          2,
+			"warn":          7,
+			"failed_checks": []interface{}{"1.1.12", "1.2.16"},
+		},
+		map[string]interface{}{
+			"type":          "penetration",
+			"request_uid":   "kube-hunter-request",
+			"state":         "Completed",
+			"message":       "",
+			"start_time":    "2026-10-01T02:00:00Z",
+			"end_time":      "2026-10-01T02:10:00Z",
+			"pass":          0,
+			"fail":          2,
+			"warn":          0,
+			"failed_checks": []interface{}{"KHV002", "KHV005"},
+		},
+		map[string]interface{}{
+			"type":          "conformance",
+			"request_uid":   "sonobuoy-request",
+			"state":         "InProgress",
+			"message":       "",
+			"start_time":    "2026-10-01T02:00:00Z",
+			"end_time":      "",
+			"pass":          310,
+			"fail":          1,
+			"warn":          0,
+			"failed_checks": []interface{}{"[sig-network] DNS should provide DNS for services"},
+		},
+	}, d.Get("reports"))
+}
+
+func TestDataSourceClusterScanResultsReadWithoutScans(t *testing.T) {
+	d := dataSourceClusterScanResults().TestResourceData()
+	_ = d.Set("cluster_uid", "test-cluster-id")
+	require.Empty(t, dataSourceClusterScanResultsRead(context.Background(), d, unitTestMockAPIClient))
+	assert.Empty(t, d.Get("reports"))
+
+	d = dataSourceClusterScanResults().TestResourceData()
+	_ = d.Set("cluster_uid", "cluster-uid-policy-error")
+	assert.NotEmpty(t, dataSourceClusterScanResultsRead(context.Background(), d, unitTestMockAPIClient))
+}
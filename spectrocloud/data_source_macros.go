@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func dataSourceMacros() *schema.Resource {
@@ -23,6 +25,7 @@ func dataSourceMacros() *schema.Resource {
 				Description:  "The context to retrieve macros from. Valid values are `project` or `tenant`. Defaults to `tenant`.",
 				ValidateFunc: validation.StringInSlice([]string{"project", "tenant"}, false),
 			},
+			"project": schemas.DataSourceProjectSchema(),
 			"macros_map": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -52,15 +55,18 @@ func dataSourceMacros() *schema.Resource {
 
 func dataSourceMacrosRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	macroContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, "")
 	var diags diag.Diagnostics
-	var err error
 
 	var uid string
 	var macros []*models.V1Macro
 
 	if macroContext == "project" {
-		uid = ProviderInitProjectUid
+		uid = getProviderProjectUID(m)
 		macros, err = c.GetMacros(uid)
 		if err != nil {
 			return diag.FromErr(err)
@@ -44,7 +44,7 @@ func dataSourcePack() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Description:   "Filters to apply when searching for a pack. This is a string of the form 'key1=value1' with 'AND', 'OR` operators. Refer to the Palette API [pack search API endpoint documentation](https://docs.spectrocloud.com/api/v1/v-1-packs-search/) for filter examples. The filter attribute will be deprecated soon; use `advance_filter` instead.",
-				ConflictsWith: []string{"id", "cloud", "name", "version", "registry_uid"},
+				ConflictsWith: []string{"id", "cloud", "name", "version", "version_constraint", "registry_uid"},
 			},
 			"advance_filters": {
 				Type:          schema.TypeList,
@@ -113,7 +113,7 @@ func dataSourcePack() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				Description:   "The UID of the pack returned.",
-				ConflictsWith: []string{"filters", "cloud", "name", "version", "registry_uid"},
+				ConflictsWith: []string{"filters", "cloud", "name", "version", "version_constraint", "registry_uid"},
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -137,6 +137,8 @@ func dataSourcePack() *schema.Resource {
 				Computed:    true,
 				Optional:    true,
 			},
+			"version_constraint": versionConstraintSchema("pack", "version", "filters", "id"),
+			"matching_versions":  matchingVersionsSchema("pack"),
 			"registry_uid": {
 				Type:        schema.TypeString,
 				Description: "The unique identifier (UID) of the registry where the pack is located. Specify `registry_uid` to search within a specific registry.",
@@ -245,6 +247,20 @@ func dataSourcePackRead(_ context.Context, d *schema.ResourceData, m interface{}
 		}
 		if v, ok := d.GetOk("version"); ok {
 			filters = append(filters, fmt.Sprintf("spec.version=%s", v.(string)))
+		} else if _, ok := d.GetOk("version_constraint"); ok {
+			summaries, err := c.GetPacks([]string{fmt.Sprintf("spec.name=%s", packName)}, registryUID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			versions := make([]string, 0, len(summaries))
+			for _, p := range summaries {
+				versions = append(versions, p.Spec.Version)
+			}
+			version, diags := selectPackVersion(d, packName, versions)
+			if diags.HasError() {
+				return diags
+			}
+			filters = append(filters, fmt.Sprintf("spec.version=%s", version))
 		} else {
 			latestVersion := setLatestPackVersionToFilters(packName, registryUID, c)
 			if latestVersion != "" {
@@ -308,7 +324,22 @@ func dataSourcePackRead(_ context.Context, d *schema.ResourceData, m interface{}
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		if ver, ok := d.GetOk("version"); ok {
+		if _, ok := d.GetOk("version_constraint"); ok {
+			versions := make([]string, 0, len(supportedVersionList.Tags))
+			for _, tag := range supportedVersionList.Tags {
+				versions = append(versions, tag.Version)
+			}
+			version, diags := selectPackVersion(d, packName, versions)
+			if diags.HasError() {
+				return diags
+			}
+			for _, tag := range supportedVersionList.Tags {
+				if tag.Version == version {
+					filters = []string{fmt.Sprintf("metadata.uid=%s", tag.PackUID)}
+					break
+				}
+			}
+		} else if ver, ok := d.GetOk("version"); ok {
 			for _, v := range supportedVersionList.Tags {
 				if ver == v.Version {
 					filters = []string{fmt.Sprintf("metadata.uid=%s", v.PackUID)}
@@ -396,6 +427,26 @@ func dataSourcePackRead(_ context.Context, d *schema.ResourceData, m interface{}
 	return diags
 }
 
+// selectPackVersion picks the highest of versions that satisfies d's
+// version_constraint and records all the matches in matching_versions.
+func selectPackVersion(d *schema.ResourceData, packName string, versions []string) (string, diag.Diagnostics) {
+	matched, _, err := resolveVersionConstraint(d, versions)
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	if len(matched) == 0 {
+		return "", diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s: no matching packs", packName),
+			Detail:   fmt.Sprintf("No version of the pack satisfies the version constraint %q", d.Get("version_constraint").(string)),
+		}}
+	}
+	if err := d.Set("matching_versions", matched); err != nil {
+		return "", diag.FromErr(err)
+	}
+	return matched[0], nil
+}
+
 func setLatestPackVersionToFilters(packName string, registryUID string, c *client.V1Client) string {
 	var packLayers = []models.V1PackLayer{models.V1PackLayerKernel, models.V1PackLayerOs, models.V1PackLayerK8s, models.V1PackLayerCni, models.V1PackLayerCsi, models.V1PackLayerAddon}
 
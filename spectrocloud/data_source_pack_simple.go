@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func dataSourcePackSimple() *schema.Resource {
@@ -25,8 +27,11 @@ func dataSourcePackSimple() *schema.Resource {
 			"version": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "The version of the pack.",
 			},
+			"version_constraint": versionConstraintSchema("pack", "version"),
+			"matching_versions":  matchingVersionsSchema("pack"),
 			"context": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -35,6 +40,7 @@ func dataSourcePackSimple() *schema.Resource {
 				Description: "Indicates in which context registry should be searched for the pack values. " +
 					"Allowed values are `system`, `project` or `tenant`. Defaults to `project`." + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.DataSourceProjectSchema(),
 			"registry_uid": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -55,8 +61,12 @@ func dataSourcePackSimple() *schema.Resource {
 	}
 }
 
-func dataSourcePackReadSimple(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourcePackReadSimple(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
@@ -110,6 +120,16 @@ func dataSourcePackReadSimple(_ context.Context, d *schema.ResourceData, m inter
 	if v, ok := d.GetOk("version"); ok {
 		version = v.(string)
 	}
+	if _, ok := d.GetOk("version_constraint"); ok {
+		versions := make([]string, 0, len(pack.Tags))
+		for _, tag := range pack.Tags {
+			versions = append(versions, tag.Version)
+		}
+		var constraintDiags diag.Diagnostics
+		if version, constraintDiags = selectPackVersion(d, packName, versions); constraintDiags.HasError() {
+			return constraintDiags
+		}
+	}
 	for _, tag := range pack.Tags {
 		if tag.Version == version {
 			d.SetId(tag.PackUID)
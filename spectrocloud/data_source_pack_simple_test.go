@@ -44,3 +44,21 @@ func TestDataSourceSimplePacksReadNoPackFound(t *testing.T) {
 	diags := dataSourcePackReadSimple(context.Background(), d, unitTestMockAPINegativeClient)
 	assertFirstDiagMessage(t, diags, "No values for pack found.")
 }
+
+func TestDataSourceSimplePacksReadVersionConstraint(t *testing.T) {
+	d := prepareBaseDataSourceSimplePackResourceData()
+	_ = d.Set("type", "other")
+	_ = d.Set("registry_uid", "test-reg-uid")
+	_ = d.Set("version_constraint", "~> 1.0")
+	diags := dataSourcePackReadSimple(context.Background(), d, unitTestMockAPIClient)
+	assert.Empty(t, diags)
+	assert.Equal(t, "1.0", d.Get("version"))
+	assert.Equal(t, []interface{}{"1.0"}, d.Get("matching_versions"))
+
+	d = prepareBaseDataSourceSimplePackResourceData()
+	_ = d.Set("type", "other")
+	_ = d.Set("registry_uid", "test-reg-uid")
+	_ = d.Set("version_constraint", ">= 2.0")
+	diags = dataSourcePackReadSimple(context.Background(), d, unitTestMockAPIClient)
+	assertFirstDiagMessage(t, diags, "k8: no matching packs")
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func dataSourceSSHKey() *schema.Resource {
@@ -42,18 +44,22 @@ func dataSourceSSHKey() *schema.Resource {
 				Description: "The context of the cluster profile. Allowed values are `project` or `tenant`. " +
 					"Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.DataSourceProjectSchema(),
 		},
 	}
 }
 
 func dataSourceSSHKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sshKeyContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, sshKeyContext)
 	var diags diag.Diagnostics
 	id := d.Get("id").(string)
 	name := d.Get("name").(string)
 	var sshKey *models.V1UserAssetSSH
-	var err error
 	if id != "" {
 		sshKey, err = c.GetSSHKey(d.Id())
 		if err != nil {
@@ -8,14 +8,12 @@ import (
 
 const featureFlagDisableAddonDeploymentResource = "disable_addon_deployment_resource"
 
-// disableAddonDeploymentResource is set from provider feature_flag configuration.
-var disableAddonDeploymentResource bool
-
 const addonDeploymentResourceDisabledMessage = "spectrocloud_addon_deployment is disabled by provider feature flag " +
 	"`disable_addon_deployment_resource`. Remove this resource from configuration or set the flag to false."
 
-func configureFeatureFlags(d *schema.ResourceData) {
-	disableAddonDeploymentResource = false
+// configureFeatureFlags reads the provider's feature_flag map into meta.
+func configureFeatureFlags(d *schema.ResourceData, meta *providerMeta) {
+	meta.disableAddonDeploymentResource = false
 
 	raw, ok := d.GetOk("feature_flag")
 	if !ok {
@@ -29,13 +27,42 @@ func configureFeatureFlags(d *schema.ResourceData) {
 
 	if v, ok := flags[featureFlagDisableAddonDeploymentResource]; ok {
 		if disabled, ok := v.(bool); ok {
-			disableAddonDeploymentResource = disabled
+			meta.disableAddonDeploymentResource = disabled
 		}
 	}
 }
 
-func addonDeploymentResourceDisabled() bool {
-	return disableAddonDeploymentResource
+// configureFeaturePreview reads the provider's feature_preview map into meta.
+func configureFeaturePreview(d *schema.ResourceData, meta *providerMeta) {
+	meta.featurePreview = map[string]bool{}
+
+	v, ok := d.GetOk("feature_preview")
+	if !ok {
+		return
+	}
+	for key, val := range v.(map[string]interface{}) {
+		switch b := val.(type) {
+		case bool:
+			meta.featurePreview[key] = b
+		case string:
+			meta.featurePreview[key] = b == "true"
+		}
+	}
+}
+
+// addonDeploymentResourceDisabled reports whether m's provider set
+// disable_addon_deployment_resource. A nil m (an unconfigured provider,
+// e.g. during validation) means the default, false.
+func addonDeploymentResourceDisabled(m interface{}) bool {
+	meta, ok := m.(*providerMeta)
+	return ok && meta.disableAddonDeploymentResource
+}
+
+// isFeaturePreviewEnabled returns true if the given feature flag name is
+// explicitly set to true in m's provider feature_preview map.
+func isFeaturePreviewEnabled(m interface{}, name string) bool {
+	meta, ok := m.(*providerMeta)
+	return ok && meta.featurePreview[name]
 }
 
 func addonDeploymentResourceDisabledError() error {
@@ -10,58 +10,54 @@ import (
 )
 
 func TestConfigureFeatureFlags(t *testing.T) {
-	t.Cleanup(func() {
-		disableAddonDeploymentResource = false
-	})
-
 	t.Run("defaults to disabled flag false", func(t *testing.T) {
 		d := prepareProviderConfigWithFeatureFlags(nil)
-		configureFeatureFlags(d)
-		assert.False(t, disableAddonDeploymentResource)
+		meta := &providerMeta{}
+		configureFeatureFlags(d, meta)
+		assert.False(t, meta.disableAddonDeploymentResource)
 	})
 
 	t.Run("enables disable_addon_deployment_resource", func(t *testing.T) {
 		d := prepareProviderConfigWithFeatureFlags(map[string]interface{}{
 			featureFlagDisableAddonDeploymentResource: true,
 		})
-		configureFeatureFlags(d)
-		assert.True(t, disableAddonDeploymentResource)
+		meta := &providerMeta{}
+		configureFeatureFlags(d, meta)
+		assert.True(t, meta.disableAddonDeploymentResource)
 	})
 
 	t.Run("ignores unknown feature flags", func(t *testing.T) {
 		d := prepareProviderConfigWithFeatureFlags(map[string]interface{}{
 			"future_flag": true,
 		})
-		configureFeatureFlags(d)
-		assert.False(t, disableAddonDeploymentResource)
+		meta := &providerMeta{}
+		configureFeatureFlags(d, meta)
+		assert.False(t, meta.disableAddonDeploymentResource)
 	})
 
 	t.Run("provider configure resets flag when omitted", func(t *testing.T) {
-		disableAddonDeploymentResource = true
 		d := prepareBaseProviderConfig()
-		configureFeatureFlags(d)
-		assert.False(t, disableAddonDeploymentResource)
+		meta := &providerMeta{disableAddonDeploymentResource: true}
+		configureFeatureFlags(d, meta)
+		assert.False(t, meta.disableAddonDeploymentResource)
 	})
 }
 
 func TestAddonDeploymentBlockedByFeatureFlag(t *testing.T) {
-	t.Cleanup(func() {
-		disableAddonDeploymentResource = false
-	})
-	disableAddonDeploymentResource = true
+	m := withTestAddonDeploymentDisabled(unitTestMockAPIClient, true)
 
 	d := prepareAddonDeploymentTestData("cluster-123_profile-1")
 	require.NotNil(t, d)
 
-	err := resourceAddonDeploymentCustomizeDiff(context.Background(), nil, nil)
+	err := resourceAddonDeploymentCustomizeDiff(context.Background(), nil, m)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), featureFlagDisableAddonDeploymentResource)
 
-	diags := resourceAddonDeploymentRead(context.Background(), d, unitTestMockAPIClient)
+	diags := resourceAddonDeploymentRead(context.Background(), d, m)
 	assert.NotEmpty(t, diags)
 	assert.Contains(t, diags[0].Summary+diags[0].Detail, featureFlagDisableAddonDeploymentResource)
 
-	diags = resourceAddonDeploymentDelete(context.Background(), d, unitTestMockAPIClient)
+	diags = resourceAddonDeploymentDelete(context.Background(), d, m)
 	assert.NotEmpty(t, diags)
 	assert.Contains(t, diags[0].Summary+diags[0].Detail, featureFlagDisableAddonDeploymentResource)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/kubevirt/schema/k8s"
 	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/kubevirt/schema/virtualmachineinstance"
 	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/kubevirt/utils"
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func VirtualMachineFields() map[string]*schema.Schema {
@@ -93,6 +94,7 @@ func VirtualMachineFields() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "If set to `true`, the virtual machine will be started when the cluster is launched. Default value is `true`.",
 		},
+		"wait_settings": schemas.WaitSettingsSchema(),
 		"vm_action": {
 			Type:         schema.TypeString,
 			Optional:     true,
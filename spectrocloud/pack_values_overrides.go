@@ -0,0 +1,125 @@
+package spectrocloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/palette-sdk-go/client"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+)
+
+// packValuesWithOverrides returns the values to send for pack p: its values
+// with values_overrides merged on top. Without values the overrides apply to
+// the pack's defaults, which come from defaults.
+func packValuesWithOverrides(p map[string]interface{}, defaults func() (string, error)) (string, error) {
+	values, _ := p["values"].(string)
+	// UI strips a single newline, so we should do the same
+	values = strings.TrimSpace(values)
+	overrides := schemas.PackValuesOverrides(p)
+	if len(overrides) == 0 {
+		return values, nil
+	}
+
+	name, _ := p["name"].(string)
+	if values == "" && defaults != nil {
+		v, err := defaults()
+		if err != nil {
+			return "", fmt.Errorf("pack %s: reading default values for values_overrides: %w", name, err)
+		}
+		values = v
+	}
+	merged, err := schemas.MergePackValues(values, overrides)
+	if err != nil {
+		return "", fmt.Errorf("pack %s: %w", name, err)
+	}
+	return merged, nil
+}
+
+// packDefaultValues returns the lookup of the registry defaults for a
+// cluster profile pack, or nil for packs that have none.
+func packDefaultValues(c *client.V1Client, packUID string, packType models.V1PackType) func() (string, error) {
+	if packUID == "" || packType == models.V1PackTypeManifest {
+		return nil
+	}
+	return registryPackValues(c, packUID)
+}
+
+// registryPackValues returns the default values of the pack with the given
+// UID, as published in its registry.
+func registryPackValues(c *client.V1Client, packUID string) func() (string, error) {
+	return func() (string, error) {
+		entry, err := getPackValuesEntry(c, packUID)
+		if err != nil {
+			return "", err
+		}
+		return entry.Values, nil
+	}
+}
+
+// getPackValuesEntry returns the default values and schema the registry
+// publishes for the pack with the given UID.
+func getPackValuesEntry(c *client.V1Client, packUID string) (*models.V1PackUIDValues, error) {
+	pack, err := c.GetPack(packUID)
+	if err != nil {
+		return nil, err
+	}
+	if pack != nil {
+		for _, v := range pack.PackValues {
+			if v != nil && v.PackUID == packUID {
+				return v, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no values found for pack %s", packUID)
+}
+
+// clusterProfilePackValues returns a lookup of the values a cluster profile
+// sets for each of its packs. The profile is only fetched on first use.
+func clusterProfilePackValues(c *client.V1Client, profileUID string) func(packName string) (string, error) {
+	var values map[string]string
+	return func(packName string) (string, error) {
+		if values == nil {
+			cp, err := c.GetClusterProfile(profileUID)
+			if err != nil {
+				return "", err
+			}
+			if cp == nil || cp.Spec == nil || cp.Spec.Published == nil {
+				return "", fmt.Errorf("cluster profile %s not found", profileUID)
+			}
+			values = make(map[string]string, len(cp.Spec.Published.Packs))
+			for _, pack := range cp.Spec.Published.Packs {
+				if pack != nil && pack.Name != nil {
+					values[*pack.Name] = pack.Values
+				}
+			}
+		}
+		v, ok := values[packName]
+		if !ok {
+			return "", fmt.Errorf("cluster profile %s has no pack %s", profileUID, packName)
+		}
+		return v, nil
+	}
+}
+
+// alignPackValuesWithOverrides carries values_overrides, which Palette does
+// not store, from the configured pack into the flattened one. For packs in a
+// cluster_profile set the values are aligned too while the overrides are
+// still applied, so the set element hashes the same as the configuration.
+func alignPackValuesWithOverrides(pack, configPack map[string]interface{}, alignValues bool) {
+	if configPack == nil {
+		return
+	}
+	overrides, ok := configPack["values_overrides"].([]interface{})
+	if !ok || len(overrides) == 0 {
+		return
+	}
+	pack["values_overrides"] = overrides
+
+	current, _ := pack["values"].(string)
+	base, _ := configPack["values"].(string)
+	if alignValues && schemas.PackValuesMatchOverrides(current, base, schemas.PackValuesOverrides(configPack)) {
+		pack["values"] = base
+	}
+}
@@ -0,0 +1,95 @@
+package spectrocloud
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/types"
+)
+
+func TestPackValuesWithOverrides(t *testing.T) {
+	c := castV1Client(t, unitTestMockAPIClient)
+	pack := func(values string, overrides ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"name": "nginx", "values": values, "values_overrides": overrides}
+	}
+
+	values, err := packValuesWithOverrides(pack("a: 1\n"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "a: 1", values)
+
+	values, err = packValuesWithOverrides(pack("a: 1\nb: {c: 2}", "b.c=3", "d: true"), registryPackValues(c, "test-pack-uid"))
+	require.NoError(t, err)
+	assert.Equal(t, "a: 1\nb: {c: 3}\nd: true", values, "explicit values are the base")
+
+	values, err = packValuesWithOverrides(pack("", "image.tag=1.26", "replicaCount=2"), packDefaultValues(c, "test-pack-uid", models.V1PackTypeSpectro))
+	require.NoError(t, err)
+	assert.Equal(t, "replicaCount: 2\nimage:\n  repository: nginx\n  # pinned by the chart\n  tag: 1.26\nservice:\n  type: ClusterIP\n  port: 80\n"+
+		"tolerations:\n  - key: dedicated\n    operator: Exists", values, "registry defaults are the base")
+
+	values, err = packValuesWithOverrides(pack("", "a=1"), packDefaultValues(c, "spectro-manifest-pack", models.V1PackTypeManifest))
+	require.NoError(t, err)
+	assert.Equal(t, "a: 1", values)
+
+	_, err = packValuesWithOverrides(pack("", "a=1"), func() (string, error) { return "", errors.New("boom") })
+	assert.EqualError(t, err, "pack nginx: reading default values for values_overrides: boom")
+	_, err = packValuesWithOverrides(pack("- a", "a=1"), nil)
+	assert.EqualError(t, err, "pack nginx: pack values must be a YAML mapping to apply values_overrides")
+}
+
+func TestToClusterProfilePackCreateWithResolutionValuesOverrides(t *testing.T) {
+	c := castV1Client(t, unitTestMockAPIClient)
+	pack, err := toClusterProfilePackCreateWithResolution(map[string]interface{}{
+		"name":             "nginx",
+		"type":             "spectro",
+		"tag":              "1.25.0",
+		"uid":              "test-pack-uid",
+		"registry_uid":     "test-reg-uid",
+		"values":           "",
+		"values_overrides": []interface{}{"service:\n  type: LoadBalancer", "tolerations=null"},
+		"manifest":         []interface{}{},
+	}, c)
+	require.NoError(t, err)
+	assert.Equal(t, "replicaCount: 1\nimage:\n  repository: nginx\n  # pinned by the chart\n  tag: \"1.25\"\nservice:\n  type: LoadBalancer\n  port: 80", pack.Values)
+}
+
+func TestSetPackValuesOverrides(t *testing.T) {
+	c := castV1Client(t, unitTestMockAPIClient)
+	profileValues := clusterProfilePackValues(c, clusterProfileDiffUID)
+
+	pack := &models.V1PackValuesEntity{Name: types.Ptr("k8")}
+	require.NoError(t, setPackValuesOverrides(pack, map[string]interface{}{"values": "", "values_overrides": []interface{}{"replicas=2"}}, profileValues))
+	assert.Equal(t, "replicas: 2\nimage: k8s:1.28", pack.Values, "the profile's values are the base")
+
+	pack = &models.V1PackValuesEntity{Name: types.Ptr("k8"), Values: "a: 1"}
+	require.NoError(t, setPackValuesOverrides(pack, map[string]interface{}{"values": "a: 1"}, profileValues))
+	assert.Equal(t, "a: 1", pack.Values, "packs without overrides are left alone")
+
+	pack = &models.V1PackValuesEntity{Name: types.Ptr("nope")}
+	err := setPackValuesOverrides(pack, map[string]interface{}{"name": "nope", "values": "", "values_overrides": []interface{}{"a=1"}}, profileValues)
+	assert.EqualError(t, err, "pack nope: reading default values for values_overrides: cluster profile "+clusterProfileDiffUID+" has no pack nope")
+}
+
+func TestAlignPackValuesWithOverrides(t *testing.T) {
+	configPack := map[string]interface{}{"name": "nginx", "values": "", "values_overrides": []interface{}{"image.tag=1.26"}}
+
+	pack := map[string]interface{}{"name": "nginx", "values": "image:\n  repository: nginx\n  tag: 1.26\n"}
+	alignPackValuesWithOverrides(pack, configPack, false)
+	assert.Equal(t, []interface{}{"image.tag=1.26"}, pack["values_overrides"])
+	assert.Equal(t, "image:\n  repository: nginx\n  tag: 1.26\n", pack["values"])
+
+	alignPackValuesWithOverrides(pack, configPack, true)
+	assert.Equal(t, "", pack["values"], "applied overrides align with the configuration")
+
+	pack = map[string]interface{}{"name": "nginx", "values": "image:\n  tag: 1.25\n"}
+	alignPackValuesWithOverrides(pack, configPack, true)
+	assert.Equal(t, "image:\n  tag: 1.25\n", pack["values"], "drift in overridden keys stays visible")
+
+	pack = map[string]interface{}{"name": "nginx", "values": "a: 1"}
+	alignPackValuesWithOverrides(pack, map[string]interface{}{"name": "nginx", "values": "a: 2"}, true)
+	alignPackValuesWithOverrides(pack, nil, true)
+	assert.Equal(t, map[string]interface{}{"name": "nginx", "values": "a: 1"}, pack)
+}
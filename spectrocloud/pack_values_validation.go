@@ -0,0 +1,324 @@
+package spectrocloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/palette-sdk-go/client"
+	"gopkg.in/yaml.v3"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+)
+
+// validateClusterProfilePackValues checks the values of the packs a plan
+// adds or changes against what their registry publishes: the values must be
+// valid YAML and are type-checked against the pack's schema. Packs whose
+// registry entry can't be looked up at plan time are only checked for valid
+// YAML. Keys the pack's default values don't have are reported by
+// clusterProfilePackValuesWarnings once the plan is applied.
+func validateClusterProfilePackValues(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if _, ok := m.(*providerMeta); !ok || !d.NewValueKnown("project") {
+		return nil
+	}
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return err
+	}
+	_, errs := checkClusterProfilePackValues(ctx, m, d.Get("context").(string), d.Get("pack"), func(key string) bool {
+		if !d.NewValueKnown(key+"values") || !d.NewValueKnown(key+"values_overrides") {
+			return false
+		}
+		return d.Id() == "" || d.HasChanges(key+"values", key+"values_overrides", key+"tag", key+"uid")
+	})
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid pack values:\n  %s", strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
+// clusterProfilePackValuesWarnings returns a warning for each key that the
+// values of an added or changed pack set but the pack's default values
+// don't have, which usually is a typo or a key the pack tag no longer uses.
+func clusterProfilePackValuesWarnings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	warnings, _ := checkClusterProfilePackValues(ctx, m, d.Get("context").(string), d.Get("pack"), func(key string) bool {
+		return d.IsNewResource() || d.HasChanges(key+"values", key+"values_overrides", key+"tag", key+"uid")
+	})
+	var diags diag.Diagnostics
+	for _, w := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unknown pack values key",
+			Detail:   w,
+		})
+	}
+	return diags
+}
+
+// checkClusterProfilePackValues validates the values of the packs for which
+// check(key) is true, where key is the pack's attribute prefix.
+func checkClusterProfilePackValues(ctx context.Context, m interface{}, resourceContext string, rawPacks interface{}, check func(key string) bool) (warnings, errs []string) {
+	packs, _ := rawPacks.([]interface{})
+
+	var c *client.V1Client
+	for i, raw := range packs {
+		p, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if !check(fmt.Sprintf("pack.%d.", i)) {
+			continue
+		}
+		values, _ := p["values"].(string)
+		overrides := schemas.PackValuesOverrides(p)
+		if strings.TrimSpace(values) == "" && len(overrides) == 0 {
+			continue
+		}
+
+		if c == nil {
+			c = getV1ClientWithResourceContext(m, resourceContext)
+		}
+		name, _ := p["name"].(string)
+		entry, err := lookupPackValuesEntry(c, p)
+		if err != nil {
+			tflog.Debug(ctx, "Skipping registry validation of pack values", map[string]interface{}{"pack": name, "error": err.Error()})
+		}
+
+		packWarnings, packErrs := validatePackValues(name, values, overrides, entry)
+		warnings = append(warnings, packWarnings...)
+		errs = append(errs, packErrs...)
+	}
+	return warnings, errs
+}
+
+// lookupPackValuesEntry finds the registry entry of pack p, resolving its UID
+// from name, tag and registry when the configuration doesn't set it. It
+// returns nil for manifest packs, which have no registry entry.
+func lookupPackValuesEntry(c *client.V1Client, p map[string]interface{}) (*models.V1PackUIDValues, error) {
+	packType, _ := p["type"].(string)
+	if packType == string(models.V1PackTypeManifest) {
+		return nil, nil
+	}
+
+	uid, _ := p["uid"].(string)
+	if uid == "" {
+		name, _ := p["name"].(string)
+		tag, _ := p["tag"].(string)
+		registryUID, _ := p["registry_uid"].(string)
+		if registryName, _ := p["registry_name"].(string); registryUID == "" && registryName != "" {
+			resolved, err := resolveRegistryNameToUID(c, registryName, packType)
+			if err != nil {
+				return nil, err
+			}
+			registryUID = resolved
+		}
+		resolved, err := resolvePackUID(c, name, tag, registryUID)
+		if err != nil {
+			return nil, err
+		}
+		uid = resolved
+	}
+	return getPackValuesEntry(c, uid)
+}
+
+// validatePackValues validates the values of pack name, with its overrides
+// applied, against the registry entry. A nil entry only checks the YAML.
+func validatePackValues(name, values string, overrides []string, entry *models.V1PackUIDValues) (warnings, errs []string) {
+	fail := func(format string, args ...interface{}) []string {
+		return []string{fmt.Sprintf("pack %s: ", name) + fmt.Sprintf(format, args...)}
+	}
+
+	root, err := parsePackValuesDocument(values)
+	if err != nil {
+		return nil, fail("values %s", err)
+	}
+	// Line numbers are only meaningful for the document the user wrote.
+	where := func(n *yaml.Node) string {
+		return fmt.Sprintf("values line %d", n.Line)
+	}
+
+	defaults := ""
+	if entry != nil {
+		defaults = entry.Values
+	}
+	if len(overrides) > 0 {
+		base := values
+		if strings.TrimSpace(base) == "" {
+			base = defaults
+		}
+		merged, err := schemas.MergePackValues(base, overrides)
+		if err != nil {
+			return nil, fail("%s", err)
+		}
+		if root, err = parsePackValuesDocument(merged); err != nil {
+			return nil, fail("values with values_overrides applied %s", err)
+		}
+		where = func(*yaml.Node) string {
+			return "values with values_overrides applied"
+		}
+	}
+	if root == nil || entry == nil {
+		return nil, nil
+	}
+
+	if known, err := parsePackValuesDocument(defaults); err == nil && known != nil {
+		for i := 0; i+1 < len(root.Content); i += 2 {
+			k := root.Content[i]
+			if yamlMappingValue(known, k.Value) == nil && !packSchemaHasKey(entry.Schema, k.Value) {
+				warnings = append(warnings, fmt.Sprintf("pack %s: %s: %q is not a key of the pack's default values", name, where(k), k.Value))
+			}
+		}
+	}
+
+	for _, s := range entry.Schema {
+		if s == nil || s.Name == "" {
+			continue
+		}
+		node := yamlPathValue(root, s.Name)
+		if node == nil {
+			if s.Required {
+				errs = append(errs, fail("values: %s is required by the pack", s.Name)...)
+			}
+			continue
+		}
+		if msg := checkPackSchemaValue(s, node); msg != "" {
+			errs = append(errs, fail("%s: %s %s", where(node), s.Name, msg)...)
+		}
+	}
+	return warnings, errs
+}
+
+// packSchemaHasKey reports whether the pack schema describes a top-level
+// key, which the defaults may leave out.
+func packSchemaHasKey(entries []*models.V1PackSchema, key string) bool {
+	for _, s := range entries {
+		if s != nil && strings.Split(s.Name, ".")[0] == key {
+			return true
+		}
+	}
+	return false
+}
+
+// parsePackValuesDocument parses pack values, which must be a YAML mapping.
+// Empty values return a nil node.
+func parsePackValuesDocument(values string) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(values), &doc); err != nil {
+		return nil, fmt.Errorf("are not valid YAML: %s", strings.TrimPrefix(err.Error(), "yaml: "))
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("must be a YAML mapping, got a %s at line %d", yamlNodeType(root), root.Line)
+	}
+	return root, nil
+}
+
+func yamlMappingValue(m *yaml.Node, key string) *yaml.Node {
+	if m == nil || m.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// yamlPathValue returns the node at a dotted path such as `image.tag`.
+func yamlPathValue(root *yaml.Node, path string) *yaml.Node {
+	node := root
+	for _, key := range strings.Split(path, ".") {
+		if node != nil && node.Kind == yaml.AliasNode {
+			node = node.Alias
+		}
+		if node = yamlMappingValue(node, key); node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+func yamlNodeType(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "list"
+	case yaml.AliasNode:
+		return yamlNodeType(n.Alias)
+	}
+	switch n.ShortTag() {
+	case "!!int":
+		return "integer"
+	case "!!float":
+		return "number"
+	case "!!bool":
+		return "boolean"
+	case "!!null":
+		return "null"
+	}
+	return "string"
+}
+
+// checkPackSchemaValue checks a value against its pack schema entry and
+// describes the problem, if any. Null values and values holding Palette
+// macros, which are only resolved at deploy time, are not checked.
+func checkPackSchemaValue(s *models.V1PackSchema, n *yaml.Node) string {
+	got := yamlNodeType(n)
+	if got == "null" || (n.Kind == yaml.ScalarNode && strings.Contains(n.Value, "{{")) {
+		return ""
+	}
+
+	var want []string
+	switch strings.ToLower(s.Type) {
+	case "string":
+		want = []string{"string"}
+	case "number", "float":
+		want = []string{"number", "integer"}
+	case "integer", "int":
+		want = []string{"integer"}
+	case "boolean", "bool":
+		want = []string{"boolean"}
+	case "list", "array":
+		want = []string{"list"}
+	case "object", "map":
+		want = []string{"object"}
+	}
+	if want != nil && !slices.Contains(want, got) {
+		return fmt.Sprintf("must be a %s, got %s %q", want[0], got, yamlNodeText(n))
+	}
+
+	if n.Kind != yaml.ScalarNode {
+		return ""
+	}
+	if len(s.ListOptions) > 0 && !slices.Contains(s.ListOptions, n.Value) {
+		return fmt.Sprintf("must be one of %s, got %q", strings.Join(s.ListOptions, ", "), n.Value)
+	}
+	if s.Regex != "" {
+		if re, err := regexp.Compile(s.Regex); err == nil && !re.MatchString(n.Value) {
+			return fmt.Sprintf("must match %s, got %q", s.Regex, n.Value)
+		}
+	}
+	return ""
+}
+
+func yamlNodeText(n *yaml.Node) string {
+	if n.Kind == yaml.ScalarNode {
+		return n.Value
+	}
+	out, err := yaml.Marshal(n)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,183 @@
+package spectrocloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePackValues(t *testing.T) {
+	entry := &models.V1PackUIDValues{
+		Values: "replicaCount: 1\nimage:\n  tag: \"1.25\"\nservice:\n  type: ClusterIP\nenabled: true\n",
+		Schema: []*models.V1PackSchema{
+			{Name: "replicaCount", Type: "number"},
+			{Name: "image.tag", Type: "string", Regex: `^[0-9.]+$`},
+			{Name: "service.type", Type: "string", ListOptions: []string{"ClusterIP", "LoadBalancer"}},
+			{Name: "enabled", Type: "boolean", Required: true},
+			{Name: "tolerations", Type: "list"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		values    string
+		overrides []string
+		entry     *models.V1PackUIDValues
+		warnings  []string
+		errs      []string
+	}{
+		{
+			name:   "valid values",
+			values: "replicaCount: 2\nimage:\n  tag: \"1.26\"\nservice:\n  type: LoadBalancer\nenabled: false",
+			entry:  entry,
+		},
+		{
+			name:   "invalid YAML",
+			values: "replicaCount: 2\nimage:\n  tag: \"1.26\n",
+			entry:  entry,
+			errs:   []string{"pack nginx: values are not valid YAML: line 3: found unexpected end of stream"},
+		},
+		{
+			name:   "not a mapping",
+			values: "- a\n- b",
+			errs:   []string{"pack nginx: values must be a YAML mapping, got a list at line 1"},
+		},
+		{
+			name:     "unknown top-level keys are warnings",
+			values:   "enabled: true\nreplicas: 2\nimage:\n  pullPolicy: Always",
+			entry:    entry,
+			warnings: []string{`pack nginx: values line 2: "replicas" is not a key of the pack's default values`},
+		},
+		{
+			name:   "schema violations carry line numbers",
+			values: "enabled: yes please\nreplicaCount: two\nimage:\n  tag: 1.26\nservice:\n  type: External\ntolerations: {key: a}",
+			entry:  entry,
+			errs: []string{
+				`pack nginx: values line 2: replicaCount must be a number, got string "two"`,
+				`pack nginx: values line 4: image.tag must be a string, got number "1.26"`,
+				`pack nginx: values line 6: service.type must be one of ClusterIP, LoadBalancer, got "External"`,
+				`pack nginx: values line 1: enabled must be a boolean, got string "yes please"`,
+				`pack nginx: values line 7: tolerations must be a list, got object "{key: a}"`,
+			},
+		},
+		{
+			name:   "regex, required and macros",
+			values: "image:\n  tag: latest\nreplicaCount: \"{{ .spectro.var.replicas }}\"",
+			entry:  entry,
+			errs: []string{
+				`pack nginx: values line 2: image.tag must match ^[0-9.]+$, got "latest"`,
+				"pack nginx: values: enabled is required by the pack",
+			},
+		},
+		{
+			name:      "overrides are applied to the defaults",
+			overrides: []string{"replicaCount=many", "extra.key=1"},
+			entry:     entry,
+			warnings:  []string{`pack nginx: values with values_overrides applied: "extra" is not a key of the pack's default values`},
+			errs:      []string{`pack nginx: values with values_overrides applied: replicaCount must be a number, got string "many"`},
+		},
+		{
+			name:   "without a registry entry only YAML is checked",
+			values: "replicaCount: two",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, errs := validatePackValues("nginx", tt.values, tt.overrides, tt.entry)
+			assert.Equal(t, tt.warnings, warnings)
+			assert.Equal(t, tt.errs, errs)
+		})
+	}
+}
+
+func TestLookupPackValuesEntry(t *testing.T) {
+	c := castV1Client(t, unitTestMockAPIClient)
+
+	entry, err := lookupPackValuesEntry(c, map[string]interface{}{"uid": "test-pack-uid", "type": "spectro"})
+	require.NoError(t, err)
+	assert.Equal(t, "test-pack-uid", entry.PackUID)
+	assert.NotEmpty(t, entry.Schema)
+
+	entry, err = lookupPackValuesEntry(c, map[string]interface{}{"name": "cm", "type": "manifest"})
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+
+	_, err = lookupPackValuesEntry(c, map[string]interface{}{"name": "k8", "type": "spectro"})
+	assert.Error(t, err, "a pack that can't be resolved")
+}
+
+// customizeDiffNewPackFixture plans a new cluster profile with a single pack.
+func customizeDiffNewPackFixture(pack map[string]interface{}) (*terraform.InstanceDiff, error) {
+	cfg := map[string]interface{}{
+		"name":    "example-addon",
+		"version": "1.0.0",
+		"context": "project",
+		"cloud":   "all",
+		"type":    "add-on",
+		"pack":    []interface{}{pack},
+	}
+	return resourceClusterProfile().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(cfg), unitTestMockAPIClient)
+}
+
+func TestResourceClusterProfileCustomizeDiff_PackValuesValidation(t *testing.T) {
+	_, err := customizeDiffNewPackFixture(map[string]interface{}{
+		"name":   "nginx",
+		"tag":    "1.25.0",
+		"uid":    "test-pack-uid",
+		"values": "replicaCount: 2\nservice:\n  type: NodePort",
+	})
+	assert.NoError(t, err)
+
+	_, err = customizeDiffNewPackFixture(map[string]interface{}{
+		"name":   "nginx",
+		"tag":    "1.25.0",
+		"uid":    "test-pack-uid",
+		"values": "replicaCount: 2\nservice:\n  type: Headless",
+	})
+	assert.EqualError(t, err, "invalid pack values:\n  pack nginx: values line 3: service.type must be one of ClusterIP, NodePort, LoadBalancer, got \"Headless\"")
+
+	_, err = customizeDiffNewPackFixture(map[string]interface{}{
+		"name":             "nginx",
+		"tag":              "1.25.0",
+		"uid":              "test-pack-uid",
+		"values_overrides": []interface{}{"image.tag=latest"},
+	})
+	assert.EqualError(t, err, "invalid pack values:\n  pack nginx: values with values_overrides applied: image.tag must match ^[0-9.]+$, got \"latest\"")
+}
+
+func TestClusterProfilePackValuesWarnings(t *testing.T) {
+	d := resourceClusterProfile().TestResourceData()
+	_ = d.Set("name", "test-cluster-profile")
+	_ = d.Set("cloud", "all")
+	_ = d.Set("type", "add-on")
+	_ = d.Set("pack", []interface{}{
+		map[string]interface{}{
+			"uid":    "test-pack-uid",
+			"type":   "spectro",
+			"name":   "nginx",
+			"tag":    "1.25.0",
+			"values": "replicaCount: 2\nreplicas: 3",
+		},
+	})
+	d.MarkNewResource()
+
+	diags := resourceClusterProfileCreate(context.Background(), d, unitTestMockAPIClient)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, `pack nginx: values line 2: "replicas" is not a key of the pack's default values`, diags[0].Detail)
+	assert.NotEmpty(t, d.Id(), "an unknown key does not fail the apply")
+
+	_, err := customizeDiffNewPackFixture(map[string]interface{}{
+		"name":   "nginx",
+		"tag":    "1.25.0",
+		"uid":    "test-pack-uid",
+		"values": "replicaCount: 2\nreplicas: 3",
+	})
+	assert.NoError(t, err, "the plan only fails on errors")
+}
@@ -2,14 +2,14 @@ package spectrocloud
 
 import (
 	"context"
-	"crypto/tls"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/spectrocloud/palette-sdk-go/client"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 // make a constant string describing which project will be specified.
@@ -18,15 +18,6 @@ const (
 		"[`project_name`](https://registry.terraform.io/providers/spectrocloud/spectrocloud/latest/docs#schema)."
 )
 
-var ProviderInitProjectUid = ""
-var ProviderFeaturePreview = map[string]bool{}
-
-// isFeaturePreviewEnabled returns true if the given feature flag name is
-// explicitly set to true in the provider's feature_preview map.
-func isFeaturePreviewEnabled(name string) bool {
-	return ProviderFeaturePreview[name]
-}
-
 func New(_ string) func() *schema.Provider {
 	return func() *schema.Provider {
 		p := &schema.Provider{
@@ -41,13 +32,33 @@ func New(_ string) func() *schema.Provider {
 					Type:        schema.TypeString,
 					Optional:    true,
 					Sensitive:   true,
-					Description: "The Spectro Cloud API key. Can also be set with the `SPECTROCLOUD_APIKEY` environment variable.",
+					Description: "The Spectro Cloud API key. Can also be set with the `SPECTROCLOUD_APIKEY` environment variable. Mutually exclusive with `jwt_token` and `username`/`password`.",
 					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_APIKEY", nil),
 				},
+				"jwt_token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "A Palette JWT to authenticate with, such as a short-lived token issued by an SSO broker. A leading `Bearer ` is ignored. The token is used as is and never refreshed. Can also be set with the `SPECTROCLOUD_JWT_TOKEN` environment variable. Mutually exclusive with `api_key` and `username`/`password`.",
+					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_JWT_TOKEN", nil),
+				},
+				"username": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The email of a local Palette user to log in as, together with `password`. The provider logs in again transparently when the session expires. Can also be set with the `SPECTROCLOUD_USERNAME` environment variable. Mutually exclusive with `api_key` and `jwt_token`.",
+					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_USERNAME", nil),
+				},
+				"password": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The password for `username`. Can also be set with the `SPECTROCLOUD_PASSWORD` environment variable.",
+					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_PASSWORD", nil),
+				},
 				"trace": {
 					Type:        schema.TypeBool,
 					Optional:    true,
-					Description: "Enable HTTP request tracing. Can also be set with the `SPECTROCLOUD_TRACE` environment variable. To enable Terraform debug logging, set `TF_LOG=DEBUG`. Visit the Terraform documentation to learn more about Terraform [debugging](https://developer.hashicorp.com/terraform/plugin/log/managing).",
+					Description: "Log the bodies of Palette API requests and responses, with secrets redacted, at `TRACE` level. Every request's method, path, status and latency is logged at `DEBUG` regardless. Can also be set with the `SPECTROCLOUD_TRACE` environment variable. API traffic is logged under the `spectrocloud_http` subsystem, so it can be enabled on its own with `TF_LOG_PROVIDER_SPECTROCLOUD_HTTP=TRACE`. Visit the Terraform documentation to learn more about Terraform [debugging](https://developer.hashicorp.com/terraform/plugin/log/managing).",
 					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_TRACE", nil),
 				},
 				"retry_attempts": {
@@ -56,6 +67,55 @@ func New(_ string) func() *schema.Provider {
 					Description: "Number of retry attempts. Can also be set with the `SPECTROCLOUD_RETRY_ATTEMPTS` environment variable. Defaults to 10.",
 					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_RETRY_ATTEMPTS", 10),
 				},
+				"max_requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description: "Maximum number of Spectro Cloud API requests the provider starts per second, e.g. `5` or `0.5`. " +
+						"Use it to stay under API throttling with high `-parallelism`. " +
+						"Can also be set with the `SPECTROCLOUD_MAX_REQUESTS_PER_SECOND` environment variable. Defaults to `0` (no limit).",
+					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_MAX_REQUESTS_PER_SECOND", 0),
+				},
+				"max_concurrent_requests": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description: "Maximum number of Spectro Cloud API requests the provider has in flight at once. " +
+						"Can also be set with the `SPECTROCLOUD_MAX_CONCURRENT_REQUESTS` environment variable. Defaults to `0` (no limit).",
+					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_MAX_CONCURRENT_REQUESTS", 0),
+				},
+				"wait_initial_delay": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: schemas.ValidateWaitDelay,
+					Description: "How long resources wait before first checking on an operation they are waiting for, e.g. `30s` or `5m`. " +
+						"Can also be set with the `SPECTROCLOUD_WAIT_INITIAL_DELAY` environment variable. Defaults to `30s`.",
+					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_WAIT_INITIAL_DELAY", "30s"),
+				},
+				"wait_poll_interval": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: schemas.ValidateWaitPollInterval,
+					Description: "How long resources wait between checks on an operation they are waiting for, e.g. `10s`. At most `2m59s`. " +
+						"Can also be set with the `SPECTROCLOUD_WAIT_POLL_INTERVAL` environment variable. Defaults to `10s`.",
+					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_WAIT_POLL_INTERVAL", "10s"),
+				},
+				"wait_backoff_multiplier": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					ValidateFunc: validation.FloatAtLeast(1),
+					Description: "Factor the poll interval grows by after each check, up to `wait_max_poll_interval`. `1` polls at a fixed interval. " +
+						"Can also be set with the `SPECTROCLOUD_WAIT_BACKOFF_MULTIPLIER` environment variable. Defaults to `1`.",
+					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_WAIT_BACKOFF_MULTIPLIER", 1),
+				},
+				"wait_max_poll_interval": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: schemas.ValidateWaitPollInterval,
+					Description: "Upper bound for the poll interval as it backs off. At most `2m59s`, which is also the default. " +
+						"Can also be set with the `SPECTROCLOUD_WAIT_MAX_POLL_INTERVAL` environment variable.",
+					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_WAIT_MAX_POLL_INTERVAL", nil),
+				},
 				"project_name": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -67,7 +127,32 @@ func New(_ string) func() *schema.Provider {
 				"ignore_insecure_tls_error": {
 					Type:        schema.TypeBool,
 					Optional:    true,
-					Description: "Ignore insecure TLS errors for Spectro Cloud API endpoints. ⚠️ WARNING: Setting this to true disables SSL certificate verification and makes connections vulnerable to man-in-the-middle attacks. Only use this in development/testing environments or when connecting to self-signed certificates in trusted networks. Defaults to false.",
+					Description: "Ignore insecure TLS errors for Spectro Cloud API endpoints. ⚠️ WARNING: Setting this to true disables SSL certificate verification and makes connections vulnerable to man-in-the-middle attacks. Only use this in development/testing environments or when connecting to self-signed certificates in trusted networks. Prefer `ca_certificate` or `ca_certificate_file` for self-signed or internal CAs. Defaults to false.",
+				},
+				"ca_certificate": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "PEM-encoded CA certificate bundle to trust, in addition to the system roots, when connecting to the Spectro Cloud API. Use this for self-hosted Palette behind an internal CA. Can also be set with the `SPECTROCLOUD_CA_CERTIFICATE` environment variable.",
+					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_CA_CERTIFICATE", nil),
+				},
+				"ca_certificate_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path to a PEM-encoded CA certificate bundle to trust, in addition to the system roots. May be combined with `ca_certificate`. Can also be set with the `SPECTROCLOUD_CA_CERTIFICATE_FILE` environment variable.",
+					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_CA_CERTIFICATE_FILE", nil),
+				},
+				"proxy_url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+					Description:  "URL of the HTTP(S) proxy to reach the Spectro Cloud API through, e.g. `http://proxy.example.com:3128`. Overrides the `HTTPS_PROXY` environment variable for the provider only. Can also be set with the `SPECTROCLOUD_PROXY_URL` environment variable.",
+					DefaultFunc:  schema.EnvDefaultFunc("SPECTROCLOUD_PROXY_URL", nil),
+				},
+				"no_proxy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Comma-separated list of hosts, domains (`.example.com`) and CIDRs that bypass the proxy, in the same format as the `NO_PROXY` environment variable, which it overrides for the provider only. Can also be set with the `SPECTROCLOUD_NO_PROXY` environment variable.",
+					DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_NO_PROXY", nil),
 				},
 				"feature_flag": {
 					Type:     schema.TypeMap,
@@ -116,9 +201,10 @@ func New(_ string) func() *schema.Provider {
 				"spectrocloud_cluster_config_template": resourceClusterConfigTemplate(),
 				"spectrocloud_cluster_config_policy":   resourceClusterConfigPolicy(),
 
-				"spectrocloud_application_profile":    resourceApplicationProfile(),
-				"spectrocloud_cluster_profile":        resourceClusterProfile(),
-				"spectrocloud_cluster_profile_import": resourceClusterProfileImportFeature(),
+				"spectrocloud_application_profile":     resourceApplicationProfile(),
+				"spectrocloud_cluster_profile":         resourceClusterProfile(),
+				"spectrocloud_cluster_profile_import":  resourceClusterProfileImportFeature(),
+				"spectrocloud_cluster_profile_version": resourceClusterProfileVersion(),
 
 				"spectrocloud_cloudaccount_custom":  resourceCloudAccountCustom(),
 				"spectrocloud_cluster_custom_cloud": resourceClusterCustomCloud(),
@@ -170,6 +256,9 @@ func New(_ string) func() *schema.Provider {
 				"spectrocloud_privatecloudgateway_dns_map": resourcePrivateCloudGatewayDNSMap(),
 
 				"spectrocloud_backup_storage_location": resourceBackupStorageLocation(),
+				"spectrocloud_cluster_backup":          resourceClusterBackup(),
+				"spectrocloud_cluster_restore":         resourceClusterRestore(),
+				"spectrocloud_cluster_scan":            resourceClusterScan(),
 
 				"spectrocloud_registry_oci":  resourceRegistryOciEcr(),
 				"spectrocloud_registry_helm": resourceRegistryHelm(),
@@ -207,7 +296,9 @@ func New(_ string) func() *schema.Provider {
 				"spectrocloud_pack":        dataSourcePack(),
 				"spectrocloud_pack_simple": dataSourcePackSimple(),
 
-				"spectrocloud_cluster_profile": dataSourceClusterProfile(),
+				"spectrocloud_cluster_profile":        dataSourceClusterProfile(),
+				"spectrocloud_cluster_profile_diff":   dataSourceClusterProfileDiff(),
+				"spectrocloud_cluster_profile_export": dataSourceClusterProfileExport(),
 
 				"spectrocloud_cloudaccount_aws": dataSourceCloudAccountAws(),
 
@@ -219,6 +310,8 @@ func New(_ string) func() *schema.Provider {
 				"spectrocloud_cloudaccount_custom":            dataSourceCloudAccountCustom(),
 
 				"spectrocloud_backup_storage_location": dataSourceBackupStorageLocation(),
+				"spectrocloud_cluster_backups":         dataSourceClusterBackups(),
+				"spectrocloud_cluster_scan_results":    dataSourceClusterScanResults(),
 
 				"spectrocloud_registry_pack": dataSourceRegistryPack(),
 				"spectrocloud_registry_helm": dataSourceRegistryHelm(),
@@ -228,6 +321,7 @@ func New(_ string) func() *schema.Provider {
 				"spectrocloud_appliance":                   dataSourceAppliance(),
 				"spectrocloud_appliances":                  dataSourceAppliances(),
 				"spectrocloud_cluster":                     dataSourceCluster(),
+				"spectrocloud_cluster_kubeconfig":          dataSourceClusterKubeconfig(),
 				"spectrocloud_cluster_group":               dataSourceClusterGroup(),
 				"spectrocloud_application_profile":         dataSourceApplicationProfile(),
 				"spectrocloud_workspace":                   dataSourceWorkspace(),
@@ -248,29 +342,18 @@ func New(_ string) func() *schema.Provider {
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	configureFeatureFlags(d)
-
 	host := d.Get("host").(string)
 	projectName := d.Get("project_name").(string)
 
-	insecure := d.Get("ignore_insecure_tls_error").(bool)
-
-	if insecure {
-		// #nosec G402
-		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	transportConfig := providerTransportConfigFrom(d)
+	httpClient, err := newProviderHTTPClient(transportConfig)
+	if err != nil {
+		return nil, diag.FromErr(err)
 	}
 
-	apiKey := ""
-	if d.Get("api_key") != nil {
-		apiKey = d.Get("api_key").(string)
-	}
-	if apiKey == "" {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to create Spectro Cloud client",
-			Detail:   "Unable to authenticate user for authenticated Spectro Cloud client",
-		})
-		return nil, diags
+	session, authOpts, authDiags := newPaletteSession(ctx, d, host, httpClient)
+	if authDiags.HasError() {
+		return nil, authDiags
 	}
 
 	retryAttempts := 10
@@ -283,36 +366,28 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		transportDebug = d.Get("trace").(bool)
 	}
 
-	c := client.New(
+	c := client.New(append([]func(*client.V1Client){
 		client.WithPaletteURI(host),
-		client.WithAPIKey(apiKey),
-		client.WithInsecureSkipVerify(insecure),
+		client.WithInsecureSkipVerify(transportConfig.insecure),
 		client.WithRetries(retryAttempts),
-	)
-	if transportDebug {
-		client.WithTransportDebug()(c)
-	}
+		client.WithContext(traceContext(ctx)),
+	}, authOpts...)...)
+	// client.New always dials through a transport of its own; swap in the
+	// one carrying the configured CA bundle, proxy and credentials.
+	c.Client = newProviderAPIClient(host, httpClient, session, retryAttempts, transportDebug)
 
 	uid, err := c.GetProjectUID(projectName)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
 	if uid != "" {
-		ProviderInitProjectUid = uid
 		client.WithScopeProject(uid)(c)
 	}
 
-	if v, ok := d.GetOk("feature_preview"); ok {
-		fp := v.(map[string]interface{})
-		for key, val := range fp {
-			switch b := val.(type) {
-			case bool:
-				ProviderFeaturePreview[key] = b
-			case string:
-				ProviderFeaturePreview[key] = b == "true"
-			}
-		}
-	}
-
-	return c, diags
+	meta := newProviderMeta(c, uid)
+	meta.ctx = traceContext(ctx)
+	meta.wait = providerWaitSettings(d)
+	configureFeatureFlags(d, meta)
+	configureFeaturePreview(d, meta)
+	return meta, diags
 }
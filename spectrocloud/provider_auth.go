@@ -0,0 +1,184 @@
+package spectrocloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/spectrocloud/palette-sdk-go/api/apiutil/transport"
+	clientv1 "github.com/spectrocloud/palette-sdk-go/api/client/version1"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/palette-sdk-go/client"
+)
+
+// paletteSession supplies the credential header for every API request. For
+// api_key and jwt_token the credential is fixed; for username/password it
+// is a session token that renew replaces by logging in again.
+type paletteSession struct {
+	header string
+	login  func(ctx context.Context) (string, error)
+
+	mu    sync.Mutex
+	token string
+}
+
+func (s *paletteSession) current() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token
+}
+
+// renew logs in again unless another request already replaced stale, in
+// which case the newer token is returned as is. Concurrent 401s therefore
+// cost a single login.
+func (s *paletteSession) renew(ctx context.Context, stale string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != stale {
+		return s.token, nil
+	}
+	token, err := s.login(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.token = token
+	return token, nil
+}
+
+// paletteAuthTransport sets the session's credential on each request and,
+// for renewable sessions, retries a request once with a fresh token when
+// Palette answers 401 — e.g. when a session expires partway through a long
+// cluster wait.
+type paletteAuthTransport struct {
+	base    http.RoundTripper
+	session *paletteSession
+}
+
+func (t *paletteAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := t.session.current()
+	res, err := t.base.RoundTrip(t.withToken(req, token))
+	if err != nil || res.StatusCode != http.StatusUnauthorized || t.session.login == nil {
+		return res, err
+	}
+	// A streamed body (multipart uploads) cannot be replayed.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return res, nil
+	}
+
+	fresh, err := t.session.renew(req.Context(), token)
+	if err != nil {
+		log.Printf("[WARN] Spectro Cloud session expired and login failed: %v", err)
+		return res, nil
+	}
+	retry := t.withToken(req, fresh)
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return res, nil
+		}
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+
+	log.Printf("[DEBUG] Spectro Cloud session renewed, retrying %s %s", req.Method, req.URL.Path)
+	return t.base.RoundTrip(retry)
+}
+
+func (t *paletteAuthTransport) withToken(req *http.Request, token string) *http.Request {
+	out := req.Clone(req.Context())
+	out.Header.Set(t.session.header, token)
+	return out
+}
+
+// newPaletteSession resolves the provider's credentials: exactly one of
+// api_key, jwt_token or username/password. Username/password logs in
+// straight away so bad credentials fail at configure time, not on the
+// first resource.
+func newPaletteSession(ctx context.Context, d *schema.ResourceData, host string, httpClient *http.Client) (*paletteSession, []func(*client.V1Client), diag.Diagnostics) {
+	apiKey := d.Get("api_key").(string)
+	jwtToken := strings.TrimSpace(strings.TrimPrefix(d.Get("jwt_token").(string), "Bearer "))
+	username := d.Get("username").(string)
+	password := d.Get("password").(string)
+
+	methods := 0
+	for _, set := range []bool{apiKey != "", jwtToken != "", username != "" || password != ""} {
+		if set {
+			methods++
+		}
+	}
+	switch {
+	case methods == 0:
+		return nil, nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to create Spectro Cloud client",
+			Detail:   "Unable to authenticate user for authenticated Spectro Cloud client. Set one of `api_key`, `jwt_token`, or `username` and `password`.",
+		}}
+	case methods > 1:
+		return nil, nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Conflicting Spectro Cloud credentials",
+			Detail:   "`api_key`, `jwt_token` and `username`/`password` are mutually exclusive. Set only one of them, taking environment variables into account.",
+		}}
+	}
+
+	switch {
+	case apiKey != "":
+		return &paletteSession{header: "ApiKey", token: apiKey}, []func(*client.V1Client){client.WithAPIKey(apiKey)}, nil
+	case jwtToken != "":
+		return &paletteSession{header: "Authorization", token: jwtToken}, []func(*client.V1Client){client.WithJWT(jwtToken)}, nil
+	}
+
+	if username == "" || password == "" {
+		return nil, nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to create Spectro Cloud client",
+			Detail:   "`username` and `password` must be set together.",
+		}}
+	}
+	session := &paletteSession{header: "Authorization", login: paletteLogin(host, httpClient, username, password)}
+	token, err := session.login(ctx)
+	if err != nil {
+		return nil, nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to log in to Spectro Cloud",
+			Detail:   err.Error(),
+		}}
+	}
+	session.token = token
+	return session, nil, nil
+}
+
+// paletteLogin returns a func that exchanges username/password for a
+// session token through V1Authenticate. It uses httpClient directly, never
+// the session transport, so a failing login cannot recurse.
+func paletteLogin(host string, httpClient *http.Client, username, password string) func(ctx context.Context) (string, error) {
+	rt := transport.NewWithClient(host, "", []string{"https"}, httpClient)
+	rt.AddSensitiveValue(password)
+	c := clientv1.New(rt, strfmt.Default)
+
+	return func(ctx context.Context) (string, error) {
+		params := clientv1.NewV1AuthenticateParamsWithContext(ctx).WithBody(&models.V1AuthLogin{
+			EmailID:  username,
+			Password: strfmt.Password(password),
+		})
+		resp, err := c.V1Authenticate(params)
+		if err != nil {
+			return "", fmt.Errorf("login as %s failed: %w", username, err)
+		}
+		if resp.Payload == nil || resp.Payload.Authorization == "" {
+			if resp.Payload != nil && resp.Payload.IsMfa {
+				return "", errors.New("the account requires multi-factor authentication, which the provider does not support; use api_key or jwt_token instead")
+			}
+			return "", errors.New("login response did not include a session token")
+		}
+		return resp.Payload.Authorization, nil
+	}
+}
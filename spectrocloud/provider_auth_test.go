@@ -0,0 +1,95 @@
+package spectrocloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/tests/mockApiServer/mockserver"
+)
+
+func TestProviderConfigAuthMethods(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     map[string]string
+		wantErr string
+	}{
+		{name: "jwt_token", set: map[string]string{"jwt_token": mockserver.JWT}},
+		{name: "jwt_token with Bearer prefix", set: map[string]string{"jwt_token": "Bearer " + mockserver.JWT}},
+		{name: "username and password", set: map[string]string{"username": mockserver.Username, "password": mockserver.Password}},
+		{name: "wrong password", set: map[string]string{"username": mockserver.Username, "password": "nope"}, wantErr: "Unable to log in to Spectro Cloud"},
+		{name: "username without password", set: map[string]string{"username": mockserver.Username}, wantErr: "Unable to create Spectro Cloud client"},
+		{name: "api_key and jwt_token", set: map[string]string{"api_key": "12345", "jwt_token": mockserver.JWT}, wantErr: "Conflicting Spectro Cloud credentials"},
+		{name: "api_key and username", set: map[string]string{"api_key": "12345", "username": mockserver.Username, "password": mockserver.Password}, wantErr: "Conflicting Spectro Cloud credentials"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := prepareBaseProviderConfig()
+			_ = d.Set("api_key", "")
+			for k, v := range tt.set {
+				_ = d.Set(k, v)
+			}
+			_, diags := providerConfigure(context.Background(), d)
+			if tt.wantErr != "" {
+				assertFirstDiagMessage(t, diags, tt.wantErr)
+				return
+			}
+			assert.Empty(t, diags)
+		})
+	}
+}
+
+// TestProviderConfigSessionRenewal expires the mock's sessions mid-run, the
+// way a Palette session times out during a long cluster wait: the next
+// calls — a GET and a POST whose body must be replayed — log in again and
+// succeed, with one login shared between them.
+func TestProviderConfigSessionRenewal(t *testing.T) {
+	d := prepareBaseProviderConfig()
+	_ = d.Set("api_key", "")
+	_ = d.Set("username", mockserver.Username)
+	_ = d.Set("password", mockserver.Password)
+	m, diags := providerConfigure(context.Background(), d)
+	require.Empty(t, diags)
+	c := m.(*providerMeta).client
+
+	logins := mockAPIServer.LoginCount()
+	mockAPIServer.ExpireSessions()
+
+	_, err := c.GetProjectUID("Default")
+	require.NoError(t, err)
+	assert.Equal(t, logins+1, mockAPIServer.LoginCount())
+
+	mockAPIServer.ExpireSessions()
+	rd := prepareSSHKeyResourceData()
+	createDiags := resourceSSHKeyCreate(context.Background(), rd, m)
+	assert.Empty(t, createDiags)
+	assert.NotEmpty(t, rd.Id())
+	assert.Equal(t, logins+2, mockAPIServer.LoginCount())
+}
+
+func TestPaletteAuthTransportStaticCredential(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		assert.Equal(t, "12345", r.Header.Get("ApiKey"))
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	rt := &paletteAuthTransport{base: http.DefaultTransport, session: &paletteSession{header: "ApiKey", token: "12345"}}
+	req := httptest.NewRequest(http.MethodPost, srv.URL, strings.NewReader("{}"))
+	req.RequestURI = ""
+	res, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	_ = res.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode, "a fixed credential is not retried")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Empty(t, req.Header.Get("ApiKey"), "the caller's request must not be mutated")
+}
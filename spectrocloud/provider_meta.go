@@ -0,0 +1,142 @@
+package spectrocloud
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/spectrocloud/palette-sdk-go/client"
+)
+
+// providerMeta is what providerConfigure returns and every CRUD function
+// receives as m. It carries per-instance state that used to live in
+// package globals, so two provider instances in one process — aliased
+// provider blocks, or tests — never see each other's configuration.
+//
+// Never type-assert m directly; go through getV1ClientWithResourceContext
+// and friends.
+type providerMeta struct {
+	client *client.V1Client
+
+	// ctx is the context API requests made through m run in. It carries the
+	// Terraform loggers of the provider, or of the CRUD call after
+	// withResourceProject, but never their cancellation.
+	ctx context.Context
+
+	// projectUID is the project the meta is scoped to: the provider's
+	// project_name, or a resource's project argument after
+	// withResourceProject.
+	projectUID string
+
+	// projects caches project name lookups for the provider instance. It
+	// is shared between a meta and the copies withResourceProject makes.
+	projects *projectCache
+
+	// featurePreview and disableAddonDeploymentResource hold the provider's
+	// feature_preview and feature_flag settings.
+	featurePreview                 map[string]bool
+	disableAddonDeploymentResource bool
+
+	// wait holds the provider's wait_* settings; see waitSettingsFor.
+	wait waitSettings
+}
+
+func newProviderMeta(c *client.V1Client, projectUID string) *providerMeta {
+	return &providerMeta{ctx: context.Background(), client: c, projectUID: projectUID, projects: &projectCache{}, wait: defaultWaitSettings}
+}
+
+// projectCache resolves project names (or UIDs) to UIDs, listing the
+// tenant's projects at most once per miss. A miss re-lists, so a project
+// created earlier in the same run is still found.
+type projectCache struct {
+	mu     sync.Mutex
+	byName map[string]string
+	uids   map[string]bool
+}
+
+func (pc *projectCache) resolve(c *client.V1Client, project string) (string, error) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	if uid, ok := pc.lookup(project); ok {
+		return uid, nil
+	}
+
+	projects, err := c.GetProjects()
+	if err != nil {
+		return "", fmt.Errorf("unable to list projects to resolve project %q: %w", project, err)
+	}
+	pc.byName = map[string]string{}
+	pc.uids = map[string]bool{}
+	for _, p := range projects.Items {
+		if p == nil || p.Metadata == nil {
+			continue
+		}
+		pc.byName[p.Metadata.Name] = p.Metadata.UID
+		pc.uids[p.Metadata.UID] = true
+	}
+
+	if uid, ok := pc.lookup(project); ok {
+		return uid, nil
+	}
+	return "", fmt.Errorf("project %q not found: specify an existing project name or UID", project)
+}
+
+func (pc *projectCache) lookup(project string) (string, bool) {
+	if uid, ok := pc.byName[project]; ok {
+		return uid, true
+	}
+	if pc.uids[project] {
+		return project, true
+	}
+	return "", false
+}
+
+// withResourceProject returns m bound to a CRUD call: its requests log
+// through ctx, tagged with d's ID, and target d's project argument when the
+// resource sets one. Reassign m with it at the top of a CRUD function so
+// every client derived from m afterwards — including in helpers that take
+// m — carries both.
+//
+// Requests do not inherit ctx's cancellation: the resource timeouts already
+// bound the waits, and cleanup calls after a timeout must still go out.
+func withResourceProject(ctx context.Context, m interface{}, d resourceProjectData) (interface{}, error) {
+	meta := m.(*providerMeta)
+	scoped := *meta
+	if ctx != nil {
+		if id := d.Id(); id != "" {
+			ctx = tflog.SetField(ctx, "spectrocloud_resource_id", id)
+		}
+		scoped.ctx = context.WithoutCancel(ctx)
+	}
+
+	project, _ := d.Get("project").(string)
+	if project == "" {
+		return &scoped, nil
+	}
+	if resourceContext, _ := d.Get("context").(string); resourceContext == "tenant" {
+		return nil, fmt.Errorf("project %q cannot be set when context is \"tenant\"", project)
+	}
+
+	uid, err := meta.projects.resolve(meta.client, project)
+	if err != nil {
+		return nil, err
+	}
+	scoped.projectUID = uid
+	return &scoped, nil
+}
+
+// resourceProjectData is what withResourceProject reads the project and
+// context from: a *schema.ResourceData during CRUD, or a *schema.ResourceDiff
+// during CustomizeDiff.
+type resourceProjectData interface {
+	Id() string
+	Get(key string) interface{}
+}
+
+// getProviderProjectUID returns the UID of the project m is scoped to.
+func getProviderProjectUID(m interface{}) string {
+	return m.(*providerMeta).projectUID
+}
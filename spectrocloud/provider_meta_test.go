@@ -0,0 +1,138 @@
+package spectrocloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spectrocloud/palette-sdk-go/client"
+)
+
+// projectScopeRecorder is a bare Palette stand-in that lists two projects
+// and records the ProjectUid header and path of every other request, so
+// tests can see which scope a call actually went out with.
+type projectScopeRecorder struct {
+	mu       sync.Mutex
+	listed   int
+	projects []string
+	paths    []string
+}
+
+func (p *projectScopeRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if r.URL.Path == "/v1/dashboard/projects/metadata" {
+		p.listed++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[{"metadata":{"name":"Default","uid":"testprojectuid"}},{"metadata":{"name":"Dev","uid":"devprojectuid"}}]}`))
+		return
+	}
+	p.projects = append(p.projects, r.Header.Get("ProjectUid"))
+	p.paths = append(p.paths, r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	_, _ = w.Write([]byte(`{"code":"ResourceNotFound","message":"not found"}`))
+}
+
+func (p *projectScopeRecorder) seen() ([]string, int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.projects...), p.listed
+}
+
+func (p *projectScopeRecorder) seenPaths() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.paths...)
+}
+
+func newProjectScopeMeta(t *testing.T) (*providerMeta, *projectScopeRecorder) {
+	rec := &projectScopeRecorder{}
+	srv := httptest.NewTLSServer(rec)
+	t.Cleanup(srv.Close)
+
+	c := client.New(
+		client.WithPaletteURI(strings.TrimPrefix(srv.URL, "https://")),
+		client.WithAPIKey(apiKey),
+		client.WithInsecureSkipVerify(true),
+		client.WithRetries(1))
+	client.WithScopeProject(projectUID)(c)
+	return newProviderMeta(c, projectUID), rec
+}
+
+func TestWithResourceProject(t *testing.T) {
+	meta, rec := newProjectScopeMeta(t)
+
+	read := func(resourceContext, project string) {
+		d := prepareSSHKeyResourceData()
+		d.SetId("scoped-ssh-key")
+		_ = d.Set("context", resourceContext)
+		_ = d.Set("project", project)
+		assert.Empty(t, resourceSSHKeyRead(context.Background(), d, meta))
+	}
+
+	read("project", "Dev")
+	read("project", "devprojectuid")
+	read("project", "")
+	read("tenant", "")
+	read("project", "Dev")
+
+	projects, listed := rec.seen()
+	assert.Equal(t, []string{"devprojectuid", "devprojectuid", projectUID, "", "devprojectuid"}, projects,
+		"a resource's project must not leak into the provider's default scope")
+	assert.Equal(t, 1, listed, "project names are resolved once and cached")
+	assert.Equal(t, projectUID, getProviderProjectUID(meta))
+}
+
+func TestWithResourceProjectErrors(t *testing.T) {
+	meta, rec := newProjectScopeMeta(t)
+
+	t.Run("tenant context", func(t *testing.T) {
+		d := prepareSSHKeyResourceData()
+		_ = d.Set("context", "tenant")
+		_ = d.Set("project", "Dev")
+		_, err := withResourceProject(context.Background(), meta, d)
+		assert.ErrorContains(t, err, `cannot be set when context is "tenant"`)
+	})
+
+	t.Run("unknown project", func(t *testing.T) {
+		d := prepareSSHKeyResourceData()
+		_ = d.Set("project", "Staging")
+		_, err := withResourceProject(context.Background(), meta, d)
+		assert.ErrorContains(t, err, `project "Staging" not found`)
+
+		// A miss re-lists, so a project created mid-run is picked up.
+		_, err = withResourceProject(context.Background(), meta, d)
+		require.Error(t, err)
+		_, listed := rec.seen()
+		assert.Equal(t, 2, listed)
+	})
+}
+
+func TestWithResourceProjectPathScoped(t *testing.T) {
+	meta, rec := newProjectScopeMeta(t)
+
+	macros := resourceMacros().TestResourceData()
+	macros.SetId("project-macros-devprojectuid")
+	_ = macros.Set("context", "project")
+	_ = macros.Set("project", "Dev")
+	_ = macros.Set("macros", map[string]interface{}{"region": "us-east-1"})
+	_ = resourceMacrosRead(context.Background(), macros, meta)
+
+	dataMacros := dataSourceMacros().TestResourceData()
+	_ = dataMacros.Set("context", "project")
+	_ = dataMacros.Set("project", "devprojectuid")
+	_ = dataSourceMacrosRead(context.Background(), dataMacros, meta)
+
+	paths := rec.seenPaths()
+	require.NotEmpty(t, paths)
+	for _, p := range paths {
+		assert.Contains(t, p, "/projects/devprojectuid/", "macros take the project in the path rather than the scope header")
+	}
+}
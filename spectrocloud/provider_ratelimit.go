@@ -0,0 +1,144 @@
+package spectrocloud
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// maxRetryAfter bounds how long a single Retry-After header may pause the
+// provider, so a misbehaving proxy cannot stall a run indefinitely.
+const maxRetryAfter = 5 * time.Minute
+
+// rateLimitTransport throttles the provider's calls to Palette: at most
+// max_requests_per_second requests start per second (token bucket) and at
+// most max_concurrent_requests are outstanding at once (semaphore). Zero
+// means no limit for either.
+//
+// A 429 carrying Retry-After pauses every request through the transport
+// until the server's deadline has passed. The 429 itself is still returned,
+// so the SDK's own retry runs as before — it just cannot go out early.
+type rateLimitTransport struct {
+	base    http.RoundTripper
+	limiter *rate.Limiter
+	sem     chan struct{}
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+func newRateLimitTransport(base http.RoundTripper, maxRequestsPerSecond float64, maxConcurrentRequests int) *rateLimitTransport {
+	t := &rateLimitTransport{base: base, limiter: rate.NewLimiter(rate.Inf, 0)}
+	if maxRequestsPerSecond > 0 {
+		burst := int(math.Ceil(maxRequestsPerSecond))
+		t.limiter = rate.NewLimiter(rate.Limit(maxRequestsPerSecond), burst)
+	}
+	if maxConcurrentRequests > 0 {
+		t.sem = make(chan struct{}, maxConcurrentRequests)
+	}
+	return t
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	if err := t.wait(req.Context()); err != nil {
+		return nil, err
+	}
+	if waited := time.Since(start); waited >= time.Millisecond {
+		tflog.SubsystemTrace(withHTTPLogSubsystem(req.Context()), httpLogSubsystem, "Spectro Cloud rate limiter held API request", map[string]interface{}{
+			"http_method": req.Method,
+			"http_path":   req.URL.Path,
+			"wait_ms":     waited.Milliseconds(),
+		})
+	}
+	if t.sem != nil {
+		// Released once the response headers are in: the body is small and
+		// read straight away, and not every caller closes it promptly.
+		defer func() { <-t.sem }()
+	}
+
+	res, err := t.base.RoundTrip(req)
+	if err == nil && res.StatusCode == http.StatusTooManyRequests {
+		if d, ok := parseRetryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+			t.pause(d)
+			tflog.SubsystemDebug(withHTTPLogSubsystem(req.Context()), httpLogSubsystem, "Spectro Cloud API throttled, holding requests until Retry-After", map[string]interface{}{
+				"http_method":    req.Method,
+				"http_path":      req.URL.Path,
+				"retry_after_ms": d.Milliseconds(),
+			})
+		}
+	}
+	return res, err
+}
+
+// wait blocks until a Retry-After pause has passed, a concurrency slot is
+// free and the token bucket allows the request. On error no slot is held.
+func (t *rateLimitTransport) wait(ctx context.Context) error {
+	t.mu.Lock()
+	pause := time.Until(t.pausedUntil)
+	t.mu.Unlock()
+	if pause > 0 {
+		timer := time.NewTimer(pause)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if t.sem != nil {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case t.sem <- struct{}{}:
+		}
+	}
+	if err := t.limiter.Wait(ctx); err != nil {
+		if t.sem != nil {
+			<-t.sem
+		}
+		return err
+	}
+	return nil
+}
+
+func (t *rateLimitTransport) pause(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if until := time.Now().Add(d); until.After(t.pausedUntil) {
+		t.pausedUntil = until
+	}
+}
+
+// parseRetryAfter reads a Retry-After header in either of its RFC 9110
+// forms, delay-seconds or an HTTP-date, capped at maxRetryAfter.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	var d time.Duration
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		d = time.Duration(secs) * time.Second
+	} else if at, err := http.ParseTime(v); err == nil {
+		d = at.Sub(now)
+	} else {
+		return 0, false
+	}
+	if d <= 0 {
+		return 0, false
+	}
+	if d > maxRetryAfter {
+		d = maxRetryAfter
+	}
+	return d, true
+}
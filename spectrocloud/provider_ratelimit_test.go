@@ -0,0 +1,187 @@
+package spectrocloud
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/tests/mockApiServer/mockserver"
+)
+
+func rateLimitGet(t *testing.T, rt http.RoundTripper, url string) int {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	res, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	_ = res.Body.Close()
+	return res.StatusCode
+}
+
+func TestRateLimitTransportRequestsPerSecond(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	// A burst of 10 goes out at once; the next 5 are spaced 100ms apart.
+	rt := newRateLimitTransport(http.DefaultTransport, 10, 0)
+	start := time.Now()
+	for i := 0; i < 15; i++ {
+		rateLimitGet(t, rt, srv.URL)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
+
+func TestRateLimitTransportConcurrentRequests(t *testing.T) {
+	var inFlight, peak int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	rt := newRateLimitTransport(http.DefaultTransport, 0, 2)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rateLimitGet(t, rt, srv.URL)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&peak))
+}
+
+func TestRateLimitTransportRetryAfter(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer srv.Close()
+
+	rt := newRateLimitTransport(http.DefaultTransport, 0, 0)
+	assert.Equal(t, http.StatusTooManyRequests, rateLimitGet(t, rt, srv.URL), "the 429 is left to the SDK to retry")
+
+	start := time.Now()
+	assert.Equal(t, http.StatusOK, rateLimitGet(t, rt, srv.URL))
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond, "the next request must wait out Retry-After")
+
+	t.Run("context cancelled while paused", func(t *testing.T) {
+		rt.pause(time.Minute)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		_, err := rt.RoundTrip(req)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestRateLimitTransportLogsUnderHTTPSubsystem(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+	rt := newRateLimitTransport(http.DefaultTransport, 0, 0)
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/v1/spectroclusters", nil)
+		require.NoError(t, err)
+		res, err := rt.RoundTrip(req)
+		require.NoError(t, err)
+		_ = res.Body.Close()
+	}
+
+	entries := decodeTraceLog(t, &buf)
+	require.Len(t, entries, 2)
+	for _, e := range entries {
+		assert.Equal(t, "provider."+httpLogSubsystem, e["@module"])
+		assert.Equal(t, "/v1/spectroclusters", e["http_path"])
+	}
+	assert.Equal(t, "debug", entries[0]["@level"])
+	assert.Equal(t, float64(1000), entries[0]["retry_after_ms"])
+	assert.Equal(t, "trace", entries[1]["@level"])
+	assert.GreaterOrEqual(t, entries[1]["wait_ms"], float64(900))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		in     string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"", 0, false},
+		{"3", 3 * time.Second, true},
+		{"0", 0, false},
+		{"-1", 0, false},
+		{"soon", 0, false},
+		{now.Add(7 * time.Second).Format(http.TimeFormat), 7 * time.Second, true},
+		{now.Add(-time.Second).Format(http.TimeFormat), 0, false},
+		{"86400", maxRetryAfter, true},
+	}
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.in, now)
+		assert.Equal(t, tt.wantOK, ok, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
+
+// TestProviderConfigHonorsRetryAfter throttles an SSH key read with a
+// Retry-After longer than the SDK's 2s first backoff: the read still
+// succeeds, and only after the server's deadline.
+func TestProviderConfigHonorsRetryAfter(t *testing.T) {
+	const path = "/v1/users/assets/sshkeys/retry-after-ssh-key"
+	defer mockAPIServer.ClearFaults()
+
+	d := prepareBaseProviderConfig()
+	_ = d.Set("max_requests_per_second", 50)
+	_ = d.Set("max_concurrent_requests", 4)
+	m, diags := providerConfigure(context.Background(), d)
+	require.Empty(t, diags)
+
+	_, err := mockAPIServer.InjectFault(mockserver.Fault{Method: "GET", Path: path,
+		Kind: mockserver.FaultStatus, Status: http.StatusTooManyRequests, RetryAfter: 3 * time.Second, Count: 1})
+	require.NoError(t, err)
+
+	rd := prepareSSHKeyResourceData()
+	rd.SetId("retry-after-ssh-key")
+	start := time.Now()
+	assert.Empty(t, resourceSSHKeyRead(context.Background(), rd, m))
+	assert.GreaterOrEqual(t, time.Since(start), 2900*time.Millisecond)
+	assert.Equal(t, "test-ssh-key", rd.Get("name"))
+}
+
+func TestProviderConfigRateLimitFromEnvironment(t *testing.T) {
+	t.Setenv("SPECTROCLOUD_MAX_REQUESTS_PER_SECOND", "2.5")
+	t.Setenv("SPECTROCLOUD_MAX_CONCURRENT_REQUESTS", "3")
+
+	d := schema.TestResourceDataRaw(t, New("111.111.111")().Schema, map[string]interface{}{})
+	cfg := providerTransportConfigFrom(d)
+	assert.Equal(t, 2.5, cfg.maxRequestsPerSecond)
+	assert.Equal(t, 3, cfg.maxConcurrentRequests)
+}
@@ -2,10 +2,13 @@ package spectrocloud
 
 import (
 	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/stretchr/testify/assert"
-	"testing"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProvider(t *testing.T) {
@@ -18,63 +21,10 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// prepareBaseProviderConfig uses the real provider schema so new provider
+// arguments are always present in the test ResourceData.
 func prepareBaseProviderConfig() *schema.ResourceData {
-	basSchema := &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"host": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The Spectro Cloud API host url. Can also be set with the `SPECTROCLOUD_HOST` environment variable. Defaults to https://api.spectrocloud.com",
-				DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_HOST", "api.spectrocloud.com"),
-			},
-			"api_key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "The Spectro Cloud API key. Can also be set with the `SPECTROCLOUD_APIKEY` environment variable.",
-				DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_APIKEY", nil),
-			},
-			"trace": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Description: "Enable HTTP request tracing. Can also be set with the `SPECTROCLOUD_TRACE` environment variable. To enable Terraform debug logging, set `TF_LOG=DEBUG`. Visit the Terraform documentation to learn more about Terraform [debugging](https://developer.hashicorp.com/terraform/plugin/log/managing).",
-				DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_TRACE", nil),
-			},
-			"retry_attempts": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Number of retry attempts. Can also be set with the `SPECTROCLOUD_RETRY_ATTEMPTS` environment variable. Defaults to 10.",
-				DefaultFunc: schema.EnvDefaultFunc("SPECTROCLOUD_RETRY_ATTEMPTS", 10),
-			},
-			"project_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "Default",
-				// cannot be empty
-				ValidateFunc: validation.StringIsNotEmpty,
-				Description:  "The Palette project the provider will target. If no value is provided, the `Default` Palette project is used. The default value is `Default`.",
-			},
-			"ignore_insecure_tls_error": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Description: "Ignore insecure TLS errors for Spectro Cloud API endpoints. Defaults to false.",
-			},
-			"feature_flag": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeBool,
-				},
-			},
-			"feature_preview": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeBool,
-				},
-			},
-		},
-	}
+	basSchema := &schema.Resource{Schema: New("111.111.111")().Schema}
 
 	d := basSchema.TestResourceData()
 	_ = d.Set("host", "127.0.0.1:8088")
@@ -93,15 +43,51 @@ func TestProviderConfig(t *testing.T) {
 }
 
 func TestIsFeaturePreviewEnabled(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
+	assert.False(t, isFeaturePreviewEnabled(nil, "immutable-clusterprofiles"))
+	assert.False(t, isFeaturePreviewEnabled(unitTestMockAPIClient, "immutable-clusterprofiles"))
 
-	ProviderFeaturePreview = map[string]bool{}
-	assert.False(t, isFeaturePreviewEnabled("immutable-clusterprofiles"))
+	m := withTestFeaturePreview(unitTestMockAPIClient, "immutable-clusterprofiles")
+	assert.True(t, isFeaturePreviewEnabled(m, "immutable-clusterprofiles"))
+	assert.False(t, isFeaturePreviewEnabled(m, "nonexistent"))
+	assert.False(t, isFeaturePreviewEnabled(unitTestMockAPIClient, "immutable-clusterprofiles"),
+		"enabling a preview on one meta must not leak into another")
+}
 
-	ProviderFeaturePreview = map[string]bool{"immutable-clusterprofiles": true}
-	assert.True(t, isFeaturePreviewEnabled("immutable-clusterprofiles"))
-	assert.False(t, isFeaturePreviewEnabled("nonexistent"))
+// TestProviderConfigureIsolation configures two providers at once, the way
+// Terraform configures aliased provider blocks, and checks that neither
+// sees the other's project or flags.
+func TestProviderConfigureIsolation(t *testing.T) {
+	dev := prepareBaseProviderConfig()
+	_ = dev.Set("project_name", "Dev")
+	_ = dev.Set("feature_preview", map[string]interface{}{"immutable-clusterprofiles": true})
+	_ = dev.Set("feature_flag", map[string]interface{}{featureFlagDisableAddonDeploymentResource: true})
+	prod := prepareBaseProviderConfig()
+
+	for i := 0; i < 5; i++ {
+		var wg sync.WaitGroup
+		var devMeta, prodMeta interface{}
+		var devDiags, prodDiags diag.Diagnostics
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			devMeta, devDiags = providerConfigure(context.Background(), dev)
+		}()
+		go func() {
+			defer wg.Done()
+			prodMeta, prodDiags = providerConfigure(context.Background(), prod)
+		}()
+		wg.Wait()
+		require.Empty(t, devDiags)
+		require.Empty(t, prodDiags)
+
+		assert.Equal(t, "devprojectuid", getProviderProjectUID(devMeta))
+		assert.True(t, isFeaturePreviewEnabled(devMeta, "immutable-clusterprofiles"))
+		assert.True(t, addonDeploymentResourceDisabled(devMeta))
+
+		assert.Equal(t, projectUID, getProviderProjectUID(prodMeta))
+		assert.False(t, isFeaturePreviewEnabled(prodMeta, "immutable-clusterprofiles"))
+		assert.False(t, addonDeploymentResourceDisabled(prodMeta))
+	}
 }
 
 func TestProviderConfigValidError(t *testing.T) {
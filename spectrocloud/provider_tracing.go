@@ -0,0 +1,193 @@
+package spectrocloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// httpLogSubsystem is the tflog subsystem the provider logs its Spectro
+// Cloud API traffic under. Its level follows TF_LOG_PROVIDER, or
+// TF_LOG_PROVIDER_SPECTROCLOUD_HTTP when set.
+const httpLogSubsystem = "spectrocloud_http"
+
+// maxLoggedBody bounds how much of a request or response body is logged.
+const maxLoggedBody = 64 << 10
+
+// sensitiveKeyParts are the substrings that mark a JSON field as secret,
+// matched against the lower-cased field name with '_' and '-' removed, so
+// aws_secret_key, secretKey and client-secret all match "secret".
+var sensitiveKeyParts = []string{
+	"password", "secret", "token", "apikey", "accesskey", "privatekey",
+	"passphrase", "credential", "authorization", "kubeconfig",
+}
+
+// tracingTransport logs every Spectro Cloud API call as a structured tflog
+// entry: method, path, status, latency and a request ID at DEBUG, plus the
+// request and response bodies at TRACE when the provider's trace argument
+// is set. Sensitive JSON fields are masked, non-JSON bodies are omitted, and
+// the provider's own credential never appears in any field.
+type tracingTransport struct {
+	base    http.RoundTripper
+	session *paletteSession
+	bodies  bool
+}
+
+// withHTTPLogSubsystem returns ctx with the httpLogSubsystem logger set up,
+// for the transports that log API traffic.
+func withHTTPLogSubsystem(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, httpLogSubsystem,
+		tflog.WithRootFields(),
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER", "SPECTROCLOUD_HTTP"))
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := withHTTPLogSubsystem(req.Context())
+	if t.session != nil {
+		if token := t.session.current(); token != "" {
+			ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, httpLogSubsystem, token)
+		}
+	}
+
+	fields := map[string]interface{}{
+		"http_method":     req.Method,
+		"http_path":       req.URL.Path,
+		"http_request_id": newTraceRequestID(),
+	}
+	if t.bodies {
+		fields["http_request_body"] = requestBodyForLog(req)
+		tflog.SubsystemTrace(ctx, httpLogSubsystem, "Sending Spectro Cloud API request", fields)
+	}
+
+	start := time.Now()
+	res, err := t.base.RoundTrip(req)
+	fields["http_duration_ms"] = time.Since(start).Milliseconds()
+	delete(fields, "http_request_body")
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, httpLogSubsystem, "Spectro Cloud API request failed", fields)
+		return res, err
+	}
+
+	fields["http_status"] = res.StatusCode
+	if id := res.Header.Get("X-Request-Id"); id != "" {
+		fields["http_request_id"] = id
+	}
+	if t.bodies {
+		fields["http_response_body"] = responseBodyForLog(res)
+		tflog.SubsystemTrace(ctx, httpLogSubsystem, "Received Spectro Cloud API response", fields)
+		return res, nil
+	}
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Spectro Cloud API request completed", fields)
+	return res, nil
+}
+
+// requestBodyForLog reads a copy of req's body through GetBody, leaving the
+// body the request is sent with untouched.
+func requestBodyForLog(req *http.Request) string {
+	if req.Body == nil || req.Body == http.NoBody {
+		return ""
+	}
+	if req.GetBody == nil {
+		return "[streamed body omitted]"
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return "[unreadable body omitted]"
+	}
+	defer body.Close()
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return "[unreadable body omitted]"
+	}
+	return redactBodyForLog(req.Header.Get("Content-Type"), b)
+}
+
+// responseBodyForLog buffers res's body so it can be logged and then read
+// again by the caller.
+func responseBodyForLog(res *http.Response) string {
+	if res.Body == nil || res.Body == http.NoBody {
+		return ""
+	}
+	b, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return "[unreadable body omitted]"
+	}
+	return redactBodyForLog(res.Header.Get("Content-Type"), b)
+}
+
+// redactBodyForLog returns a JSON body with its sensitive fields masked.
+// Anything that is not JSON — kubeconfigs, archives, multipart uploads — is
+// reduced to its size, since there is no telling what secrets it holds.
+func redactBodyForLog(contentType string, b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	var v interface{}
+	if (mediaType == "" || strings.HasSuffix(mediaType, "json")) && json.Unmarshal(b, &v) == nil {
+		out, err := json.Marshal(redactJSONForLog(v))
+		if err == nil {
+			if len(out) > maxLoggedBody {
+				return string(out[:maxLoggedBody]) + "...[truncated]"
+			}
+			return string(out)
+		}
+	}
+	if mediaType == "" {
+		mediaType = "unknown content"
+	}
+	return fmt.Sprintf("[%d bytes of %s omitted]", len(b), mediaType)
+}
+
+func redactJSONForLog(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if isSensitiveLogKey(k) && val != nil {
+				v[k] = "***"
+				continue
+			}
+			v[k] = redactJSONForLog(val)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactJSONForLog(v[i])
+		}
+	}
+	return v
+}
+
+func isSensitiveLogKey(key string) bool {
+	k := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(k, part) {
+			return true
+		}
+	}
+	return false
+}
+
+func newTraceRequestID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// traceContext returns the provider-level logging context for requests made
+// outside any CRUD call, detached from the configure call's cancellation.
+func traceContext(ctx context.Context) context.Context {
+	return context.WithoutCancel(ctx)
+}
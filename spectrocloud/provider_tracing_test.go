@@ -0,0 +1,135 @@
+package spectrocloud
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeTraceLog(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	entries, err := tflogtest.MultilineJSONDecode(buf)
+	require.NoError(t, err)
+	return entries
+}
+
+func TestTracingTransportRedactsSecrets(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-42")
+		_, _ = w.Write([]byte(`{"metadata":{"name":"aws-1"},"spec":{"accessKey":"AKIAEXAMPLE","secretKey":"aws-response-secret"}}`))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+	ctx = tflog.SetField(ctx, "spectrocloud_resource_id", "account-uid")
+	rt := &tracingTransport{
+		base:    http.DefaultTransport,
+		session: &paletteSession{header: "ApiKey", token: "session-api-key"},
+		bodies:  true,
+	}
+
+	body := `{"aws_secret_key":"aws-request-secret","vsphere_password":"vsphere-pass","nested":[{"client_secret":"azure-secret","api_key":"key-123","Authorization":"Bearer abc"}],"name":"session-api-key"}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/v1/cloudaccounts/aws", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	var resBody bytes.Buffer
+	_, _ = resBody.ReadFrom(res.Body)
+	assert.Contains(t, resBody.String(), "aws-response-secret", "the caller must still see the unredacted response")
+
+	logged := buf.String()
+	for _, secret := range []string{"aws-request-secret", "vsphere-pass", "azure-secret", "key-123", "Bearer abc", "AKIAEXAMPLE", "aws-response-secret", "session-api-key"} {
+		assert.NotContains(t, logged, secret)
+	}
+
+	entries := decodeTraceLog(t, &buf)
+	require.Len(t, entries, 2)
+	res0, res1 := entries[0], entries[1]
+	assert.Equal(t, "provider."+httpLogSubsystem, res1["@module"])
+	assert.Equal(t, "POST", res0["http_method"])
+	assert.Equal(t, "/v1/cloudaccounts/aws", res1["http_path"])
+	assert.Equal(t, float64(http.StatusOK), res1["http_status"])
+	assert.Equal(t, "req-42", res1["http_request_id"])
+	assert.Contains(t, res1, "http_duration_ms")
+	assert.Equal(t, "account-uid", res1["spectrocloud_resource_id"])
+	assert.Contains(t, res0["http_request_body"], `"aws_secret_key":"***"`)
+	assert.Contains(t, res1["http_response_body"], `"name":"aws-1"`)
+}
+
+func TestTracingTransportWithoutBodies(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"password":"p"}`))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+	rt := &tracingTransport{base: http.DefaultTransport}
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/v1/users/info?secret=query", nil)
+	res, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	_ = res.Body.Close()
+
+	entries := decodeTraceLog(t, &buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "debug", entries[0]["@level"])
+	assert.Equal(t, "/v1/users/info", entries[0]["http_path"], "query strings are left out of the log")
+	assert.NotEmpty(t, entries[0]["http_request_id"])
+	assert.NotContains(t, entries[0], "http_response_body")
+}
+
+func TestRedactBodyForLog(t *testing.T) {
+	tests := []struct {
+		contentType string
+		body        string
+		want        string
+	}{
+		{"application/json", ``, ``},
+		{"application/json", `{"name":"n","password":"p"}`, `{"name":"n","password":"***"}`},
+		{"application/json; charset=utf-8", `{"spec":{"SecretKey":"s","region":"us"}}`, `{"spec":{"SecretKey":"***","region":"us"}}`},
+		{"", `[{"private-key":"k"},{"token":null}]`, `[{"private-key":"***"},{"token":null}]`},
+		{"application/json", `{"kubeconfig":{"clusters":[]}}`, `{"kubeconfig":"***"}`},
+		{"application/octet-stream", `apiVersion: v1`, `[14 bytes of application/octet-stream omitted]`},
+		{"application/json", `not json`, `[8 bytes of application/json omitted]`},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, redactBodyForLog(tt.contentType, []byte(tt.body)), tt.body)
+	}
+}
+
+// TestProviderRequestsLoggedWithResource reads an SSH key through a
+// configured provider and checks the API call is logged against it.
+func TestProviderRequestsLoggedWithResource(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+
+	m, diags := providerConfigure(ctx, prepareBaseProviderConfig())
+	require.Empty(t, diags)
+
+	rd := prepareSSHKeyResourceData()
+	rd.SetId("test-ssh-key-id")
+	buf.Reset()
+	assert.Empty(t, resourceSSHKeyRead(ctx, rd, m))
+
+	var found bool
+	for _, e := range decodeTraceLog(t, &buf) {
+		if e["http_path"] == "/v1/users/assets/sshkeys/test-ssh-key-id" {
+			found = true
+			assert.Equal(t, "test-ssh-key-id", e["spectrocloud_resource_id"])
+			assert.Equal(t, "GET", e["http_method"])
+		}
+	}
+	assert.True(t, found, "the SSH key read must be logged")
+}
@@ -0,0 +1,137 @@
+package spectrocloud
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/net/http/httpproxy"
+
+	"github.com/spectrocloud/palette-sdk-go/api/apiutil/transport"
+	clientv1 "github.com/spectrocloud/palette-sdk-go/api/client/version1"
+)
+
+// providerTransportConfig holds the provider arguments that shape the HTTP
+// transport used to reach Palette.
+type providerTransportConfig struct {
+	insecure          bool
+	caCertificate     string
+	caCertificateFile string
+	proxyURL          string
+	noProxy           string
+
+	maxRequestsPerSecond  float64
+	maxConcurrentRequests int
+}
+
+func providerTransportConfigFrom(d *schema.ResourceData) providerTransportConfig {
+	return providerTransportConfig{
+		insecure:          d.Get("ignore_insecure_tls_error").(bool),
+		caCertificate:     d.Get("ca_certificate").(string),
+		caCertificateFile: d.Get("ca_certificate_file").(string),
+		proxyURL:          d.Get("proxy_url").(string),
+		noProxy:           d.Get("no_proxy").(string),
+
+		maxRequestsPerSecond:  d.Get("max_requests_per_second").(float64),
+		maxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+	}
+}
+
+// newProviderHTTPClient builds a dedicated http.Client for the Palette API.
+// It starts from a clone of http.DefaultTransport so the usual timeouts and
+// HTTP/2 settings carry over, but never mutates the shared default: two
+// provider instances in one process (aliases, or the acceptance tests) can
+// carry different CA, proxy and rate limit settings.
+func newProviderHTTPClient(cfg providerTransportConfig) (*http.Client, error) {
+	rootCAs, err := providerRootCAs(cfg.caCertificate, cfg.caCertificateFile)
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := providerProxyFunc(cfg.proxyURL, cfg.noProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = proxy
+	t.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: cfg.insecure, // #nosec G402 -- opt-in via ignore_insecure_tls_error
+		RootCAs:            rootCAs,
+		MinVersion:         tls.VersionTLS12,
+	}
+	return &http.Client{Transport: newRateLimitTransport(t, cfg.maxRequestsPerSecond, cfg.maxConcurrentRequests)}, nil
+}
+
+// providerRootCAs returns the system pool extended with the PEM bundle from
+// ca_certificate and/or ca_certificate_file, or nil (meaning "system pool")
+// when neither is set.
+func providerRootCAs(caCertificate, caCertificateFile string) (*x509.CertPool, error) {
+	if caCertificate == "" && caCertificateFile == "" {
+		return nil, nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if caCertificate != "" && !pool.AppendCertsFromPEM([]byte(caCertificate)) {
+		return nil, fmt.Errorf("ca_certificate does not contain any valid PEM certificates")
+	}
+	if caCertificateFile != "" {
+		pem, err := os.ReadFile(caCertificateFile) // #nosec G304 -- path comes from provider configuration
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca_certificate_file: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("ca_certificate_file %s does not contain any valid PEM certificates", caCertificateFile)
+		}
+	}
+	return pool, nil
+}
+
+// providerProxyFunc resolves proxy_url and no_proxy into a Transport.Proxy
+// func. With neither set the standard HTTPS_PROXY/NO_PROXY environment
+// variables apply, as before; no_proxy alone narrows the environment proxy.
+func providerProxyFunc(proxyURL, noProxy string) (func(*http.Request) (*url.URL, error), error) {
+	if proxyURL == "" && noProxy == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+
+	cfg := httpproxy.FromEnvironment()
+	if proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid proxy_url %q: must be an absolute URL such as http://proxy.example.com:3128", proxyURL)
+		}
+		cfg.HTTPProxy = proxyURL
+		cfg.HTTPSProxy = proxyURL
+	}
+	if noProxy != "" {
+		cfg.NoProxy = noProxy
+	}
+
+	proxyForURL := cfg.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyForURL(req.URL)
+	}, nil
+}
+
+// newProviderAPIClient builds the palette-sdk-go service client on top of
+// httpClient, with session supplying the credentials and every request
+// traced through tflog, bodies included when traceBodies is set. It mirrors
+// what client.New does internally, which only ever uses its own transport.
+func newProviderAPIClient(host string, httpClient *http.Client, session *paletteSession, retryAttempts int, traceBodies bool) clientv1.ClientService {
+	authed := &http.Client{Transport: &tracingTransport{
+		base:    &paletteAuthTransport{base: httpClient.Transport, session: session},
+		session: session,
+		bodies:  traceBodies,
+	}}
+	rt := transport.NewWithClient(host, "", []string{"https"}, authed)
+	rt.RetryAttempts = retryAttempts
+	return clientv1.New(rt, strfmt.Default)
+}
@@ -0,0 +1,148 @@
+package spectrocloud
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProviderHTTPClientCustomCA(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	get := func(cfg providerTransportConfig) error {
+		c, err := newProviderHTTPClient(cfg)
+		require.NoError(t, err)
+		res, err := c.Get(srv.URL)
+		if err == nil {
+			_ = res.Body.Close()
+		}
+		return err
+	}
+
+	assert.Error(t, get(providerTransportConfig{}), "self-signed server must be rejected without the CA")
+	assert.NoError(t, get(providerTransportConfig{caCertificate: caPEM}))
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte(caPEM), 0o600))
+	assert.NoError(t, get(providerTransportConfig{caCertificateFile: caFile}))
+
+	_, err := newProviderHTTPClient(providerTransportConfig{caCertificate: "not a certificate"})
+	assert.ErrorContains(t, err, "ca_certificate does not contain any valid PEM certificates")
+	_, err = newProviderHTTPClient(providerTransportConfig{caCertificateFile: filepath.Join(t.TempDir(), "missing.pem")})
+	assert.ErrorContains(t, err, "unable to read ca_certificate_file")
+}
+
+func TestNewProviderHTTPClientLeavesDefaultTransport(t *testing.T) {
+	before := http.DefaultTransport.(*http.Transport).TLSClientConfig
+
+	c, err := newProviderHTTPClient(providerTransportConfig{insecure: true})
+	require.NoError(t, err)
+	assert.True(t, c.Transport.(*rateLimitTransport).base.(*http.Transport).TLSClientConfig.InsecureSkipVerify)
+
+	assert.Same(t, before, http.DefaultTransport.(*http.Transport).TLSClientConfig)
+	d := prepareBaseProviderConfig()
+	_, diags := providerConfigure(context.Background(), d)
+	require.Empty(t, diags)
+	assert.Same(t, before, http.DefaultTransport.(*http.Transport).TLSClientConfig,
+		"ignore_insecure_tls_error must not leak into http.DefaultTransport")
+}
+
+func TestProviderProxyFunc(t *testing.T) {
+	const proxy = "http://proxy.corp.example:3128"
+	tests := []struct {
+		name      string
+		proxyURL  string
+		noProxy   string
+		target    string
+		wantProxy string
+		wantErr   string
+	}{
+		{name: "proxied", proxyURL: proxy, target: "https://api.spectrocloud.com/v1/users/info", wantProxy: proxy},
+		{name: "exact host bypass", proxyURL: proxy, noProxy: "palette.internal", target: "https://palette.internal/v1", wantProxy: ""},
+		{name: "domain suffix bypass", proxyURL: proxy, noProxy: ".corp.example", target: "https://palette.corp.example/v1", wantProxy: ""},
+		{name: "cidr bypass", proxyURL: proxy, noProxy: "10.0.0.0/8", target: "https://10.1.2.3/v1", wantProxy: ""},
+		{name: "not in no_proxy", proxyURL: proxy, noProxy: "palette.internal", target: "https://api.spectrocloud.com/v1", wantProxy: proxy},
+		{name: "relative url rejected", proxyURL: "proxy:3128", wantErr: "invalid proxy_url"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn, err := providerProxyFunc(tt.proxyURL, tt.noProxy)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			got, err := fn(req)
+			require.NoError(t, err)
+			if tt.wantProxy == "" {
+				assert.Nil(t, got)
+			} else {
+				require.NotNil(t, got)
+				assert.Equal(t, tt.wantProxy, got.String())
+			}
+		})
+	}
+}
+
+// TestProviderConfigThroughProxy points the provider at a hostname that only
+// a CONNECT proxy can resolve: the proxy tunnels it to the mock API, so
+// providerConfigure's project lookup succeeding proves every API call went
+// through proxy_url.
+func TestProviderConfigThroughProxy(t *testing.T) {
+	var mu sync.Mutex
+	var tunneled []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "CONNECT only", http.StatusMethodNotAllowed)
+			return
+		}
+		mu.Lock()
+		tunneled = append(tunneled, r.Host)
+		mu.Unlock()
+
+		upstream, err := net.Dial("tcp", mockAPIServer.PositiveAddr())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			_ = upstream.Close()
+			return
+		}
+		_, _ = conn.Write([]byte("HTTP/1.1 200 Connection Established\r\n\r\n"))
+		go func() {
+			_, _ = io.Copy(upstream, conn)
+			_ = upstream.Close()
+		}()
+		_, _ = io.Copy(conn, upstream)
+		_ = conn.Close()
+	}))
+	defer proxy.Close()
+
+	d := prepareBaseProviderConfig()
+	_ = d.Set("host", "palette.proxied.test")
+	_ = d.Set("proxy_url", proxy.URL)
+	_, diags := providerConfigure(context.Background(), d)
+	require.Empty(t, diags)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotEmpty(t, tunneled)
+	assert.Equal(t, "palette.proxied.test:443", tunneled[0])
+}
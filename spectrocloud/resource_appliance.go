@@ -84,6 +84,7 @@ func resourceAppliance() *schema.Resource {
 				ForceNew:    true,
 				Description: "If set to `true`, the resource creation will wait for the appliance provisioning process to complete before returning. Defaults to `false`.",
 			},
+			"wait_settings": schemas.WaitSettingsSchema(),
 		},
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			remoteShell := d.Get("remote_shell").(string)
@@ -120,13 +121,12 @@ func resourceApplianceCreate(ctx context.Context, d *schema.ResourceData, m inte
 	// Wait, catching any errors
 	if d.Get("wait") != nil && d.Get("wait").(bool) {
 		stateConf := &retry.StateChangeConf{
-			Pending:    resourceApplianceCreatePendingStates,
-			Target:     []string{"ready_healthy"},
-			Refresh:    resourceApplianceStateRefreshFunc(c, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutCreate) - 1*time.Minute,
-			MinTimeout: 10 * time.Second,
-			Delay:      resolveWaitDelay(30 * time.Second),
+			Pending: resourceApplianceCreatePendingStates,
+			Target:  []string{"ready_healthy"},
+			Refresh: resourceApplianceStateRefreshFunc(c, d.Id()),
+			Timeout: d.Timeout(schema.TimeoutCreate) - 1*time.Minute,
 		}
+		waitSettingsFor(m, d).apply(stateConf)
 
 		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
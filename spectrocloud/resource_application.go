@@ -8,6 +8,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func resourceApplication() *schema.Resource {
@@ -102,11 +104,17 @@ func resourceApplication() *schema.Resource {
 					},
 				},
 			},
+			"project":       schemas.ProjectSchema(),
+			"wait_settings": schemas.WaitSettingsSchema(),
 		},
 	}
 }
 
 func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	resourceContext := ""
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -120,7 +128,6 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, m in
 	val_error := errors.New("config block should have either 'cluster_uid' or 'cluster_group_uid' attributes specified")
 
 	var uid string
-	var err error
 	var config map[string]interface{}
 	var cluster_uid interface{}
 	configList := d.Get("config")
@@ -163,7 +170,7 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	d.SetId(uid)
 
-	diagnostics, isError := waitForApplicationCreation(ctx, d, diags, c)
+	diagnostics, isError := waitForApplicationCreation(ctx, d, diags, c, m)
 	if isError {
 		return diagnostics
 	}
@@ -174,7 +181,11 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 //goland:noinspection GoUnhandledErrorResult
-func resourceApplicationRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	// Get the resource context from existing configuration, default to project
 	resourceContext := "project"
 	configList := d.Get("config")
@@ -268,6 +279,10 @@ func resourceApplicationRead(_ context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -305,7 +320,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, m in
 			return diag.FromErr(err)
 		}
 		d.SetId(getAddonDeploymentId(clusterUid, clusterProfile))
-		diagnostics, isError := waitForApplicationUpdate(ctx, d, diags, c)
+		diagnostics, isError := waitForApplicationUpdate(ctx, d, diags, c, m)
 		if isError {
 			return diagnostics
 		}
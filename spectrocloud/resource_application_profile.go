@@ -57,6 +57,7 @@ func resourceApplicationProfile() *schema.Resource {
 				Description: "Context of the profile. Allowed values are `project`, `cluster`, or `namespace`. " +
 					"Default value is `project`." + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"tags": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -84,6 +85,10 @@ func resourceApplicationProfile() *schema.Resource {
 
 func resourceApplicationProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ProfileContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, ProfileContext)
 
 	// Warning or errors can be collected in a slice type
@@ -108,6 +113,10 @@ func resourceApplicationProfileCreate(ctx context.Context, d *schema.ResourceDat
 
 func resourceApplicationProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ProfileContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, ProfileContext)
 
 	var diags diag.Diagnostics
@@ -297,6 +306,10 @@ func flattenAppPacks(c *client.V1Client, diagPacks []*models.V1PackManifestEntit
 
 func resourceApplicationProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ProfileContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, ProfileContext)
 
 	// Warning or errors can be collected in a slice type
@@ -335,13 +348,17 @@ func resourceApplicationProfileUpdate(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
-func resourceApplicationProfileDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceApplicationProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ProfileContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, ProfileContext)
 
 	var diags diag.Diagnostics
 
-	err := c.DeleteApplicationProfile(d.Id())
+	err = c.DeleteApplicationProfile(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
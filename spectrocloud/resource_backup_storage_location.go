@@ -8,6 +8,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 const (
@@ -63,6 +65,7 @@ func resourceBackupStorageLocation() *schema.Resource {
 				Description: "The context of the backup storage location. Allowed values are `project` or `tenant`. " +
 					"Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"is_default": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -211,6 +214,10 @@ func resourceBackupStorageLocation() *schema.Resource {
 
 func resourceBackupStorageLocationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	assetContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, assetContext)
 	storageProvider := d.Get("storage_provider").(string)
 
@@ -230,6 +237,10 @@ func resourceBackupStorageLocationCreate(ctx context.Context, d *schema.Resource
 
 func resourceBackupStorageLocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	assetContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, assetContext)
 	storageProvider := d.Get("storage_provider").(string)
 
@@ -249,6 +260,10 @@ func resourceBackupStorageLocationRead(ctx context.Context, d *schema.ResourceDa
 
 func resourceBackupStorageLocationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	assetContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, assetContext)
 
 	storageProvider := d.Get("storage_provider").(string)
@@ -269,9 +284,13 @@ func resourceBackupStorageLocationUpdate(ctx context.Context, d *schema.Resource
 
 func resourceBackupStorageLocationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	assetContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, assetContext)
 	var diags diag.Diagnostics
-	err := c.DeleteS3BackupStorageLocation(d.Id())
+	err = c.DeleteS3BackupStorageLocation(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
 	"github.com/spectrocloud/palette-sdk-go/client"
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 	"github.com/spectrocloud/terraform-provider-spectrocloud/types"
 )
 
@@ -36,6 +37,7 @@ func resourceCloudAccountApacheCloudStack() *schema.Resource {
 				Description: "The context of the Apache CloudStack configuration. " +
 					"Allowed values are `project` or `tenant`. Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"private_cloud_gateway_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -76,6 +78,10 @@ func resourceCloudAccountApacheCloudStack() *schema.Resource {
 
 func resourceCloudAccountApacheCloudStackCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -93,8 +99,12 @@ func resourceCloudAccountApacheCloudStackCreate(ctx context.Context, d *schema.R
 	return diags
 }
 
-func resourceCloudAccountApacheCloudStackRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountApacheCloudStackRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -133,13 +143,17 @@ func resourceCloudAccountApacheCloudStackRead(_ context.Context, d *schema.Resou
 
 func resourceCloudAccountApacheCloudStackUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
 
 	account := toApacheCloudStackAccount(d, c)
 
-	err := c.UpdateCloudAccountCloudStack(account)
+	err = c.UpdateCloudAccountCloudStack(account)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -149,14 +163,18 @@ func resourceCloudAccountApacheCloudStackUpdate(ctx context.Context, d *schema.R
 	return diags
 }
 
-func resourceCloudAccountApacheCloudStackDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountApacheCloudStackDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
 
 	cloudAccountID := d.Id()
-	err := c.DeleteCloudAccountCloudStack(cloudAccountID)
+	err = c.DeleteCloudAccountCloudStack(cloudAccountID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -191,9 +209,13 @@ func toApacheCloudStackAccount(d *schema.ResourceData, c *client.V1Client) *mode
 
 func resourceAccountApacheCloudStackImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return nil, err
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
-	err := GetCommonAccount(d, c, "apache-cloudstack")
+	err = GetCommonAccount(d, c, "apache-cloudstack")
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 	"github.com/spectrocloud/terraform-provider-spectrocloud/types"
 )
 
@@ -35,6 +36,7 @@ func resourceCloudAccountAws() *schema.Resource {
 				Description: "The context of the AWS configuration. Allowed values are `project` or `tenant`. " +
 					"Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"private_cloud_gateway_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -106,6 +108,10 @@ Default is 'aws'.`,
 
 func resourceCloudAccountAwsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
@@ -128,8 +134,12 @@ func resourceCloudAccountAwsCreate(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func resourceCloudAccountAwsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountAwsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -154,6 +164,10 @@ func resourceCloudAccountAwsRead(_ context.Context, d *schema.ResourceData, m in
 
 func resourceCloudAccountAwsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
@@ -174,15 +188,19 @@ func resourceCloudAccountAwsUpdate(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func resourceCloudAccountAwsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountAwsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
 
 	cloudAccountID := d.Id()
 
-	err := c.DeleteCloudAccountAws(cloudAccountID)
+	err = c.DeleteCloudAccountAws(cloudAccountID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
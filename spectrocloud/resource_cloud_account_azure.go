@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 	"github.com/spectrocloud/terraform-provider-spectrocloud/types"
 )
 
@@ -36,6 +37,7 @@ func resourceCloudAccountAzure() *schema.Resource {
 				Description: "The context of the Azure configuration. " +
 					"Defaults to `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"private_cloud_gateway_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -88,6 +90,10 @@ Default is 'AzurePublicCloud'.`,
 
 func resourceCloudAccountAzureCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
@@ -112,8 +118,12 @@ func resourceCloudAccountAzureCreate(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
-func resourceCloudAccountAzureRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountAzureRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -174,6 +184,10 @@ func flattenCloudAccountAzure(d *schema.ResourceData, account *models.V1AzureAcc
 
 func resourceCloudAccountAzureUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
@@ -186,7 +200,7 @@ func resourceCloudAccountAzureUpdate(ctx context.Context, d *schema.ResourceData
 
 	account := toAzureAccount(d)
 
-	err := c.UpdateCloudAccountAzure(account)
+	err = c.UpdateCloudAccountAzure(account)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -196,15 +210,19 @@ func resourceCloudAccountAzureUpdate(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
-func resourceCloudAccountAzureDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountAzureDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
 
 	cloudAccountID := d.Id()
 	//AccountContext := d.Get("context").(string)
-	err := c.DeleteCloudAccountAzure(cloudAccountID)
+	err = c.DeleteCloudAccountAzure(cloudAccountID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -265,9 +283,13 @@ func validateTlsCertConfiguration(d *schema.ResourceData) error {
 
 func resourceAccountAzureImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return nil, err
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
-	err := GetCommonAccount(d, c, "azure")
+	err = GetCommonAccount(d, c, "azure")
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func resourceCloudAccountCustom() *schema.Resource {
@@ -41,6 +43,7 @@ func resourceCloudAccountCustom() *schema.Resource {
 				Description: "The context of the custom cloud configuration. Allowed values are `project` or `tenant`. " +
 					"Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"private_cloud_gateway_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -62,6 +65,10 @@ func resourceCloudAccountCustom() *schema.Resource {
 
 func resourceCloudAccountCustomCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 	var diags diag.Diagnostics
 
@@ -69,7 +76,7 @@ func resourceCloudAccountCustomCreate(ctx context.Context, d *schema.ResourceDat
 	cloudType := d.Get("cloud").(string)
 
 	// For custom cloud we need to validate cloud type id isCustom for all actions.
-	err := c.ValidateCustomCloudType(d.Get("cloud").(string))
+	err = c.ValidateCustomCloudType(d.Get("cloud").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -87,8 +94,12 @@ func resourceCloudAccountCustomCreate(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
-func resourceCloudAccountCustomRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountCustomRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -112,6 +123,10 @@ func resourceCloudAccountCustomRead(_ context.Context, d *schema.ResourceData, m
 
 func resourceCloudAccountCustomUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
@@ -130,14 +145,18 @@ func resourceCloudAccountCustomUpdate(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
-func resourceCloudAccountCustomDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountCustomDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
 	customAccountID := d.Id()
 	cloudType := d.Get("cloud").(string)
-	err := c.DeleteCloudAccountCustomCloud(customAccountID, cloudType)
+	err = c.DeleteCloudAccountCustomCloud(customAccountID, cloudType)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func resourceCloudAccountGcp() *schema.Resource {
@@ -33,6 +35,7 @@ func resourceCloudAccountGcp() *schema.Resource {
 				Description: "The context of the GCP configuration. " +
 					"Allowed values are `project` or `tenant`. Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"gcp_json_credentials": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -45,6 +48,10 @@ func resourceCloudAccountGcp() *schema.Resource {
 
 func resourceCloudAccountGcpCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
@@ -63,8 +70,12 @@ func resourceCloudAccountGcpCreate(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func resourceCloudAccountGcpRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountGcpRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -91,6 +102,10 @@ func resourceCloudAccountGcpRead(_ context.Context, d *schema.ResourceData, m in
 
 func resourceCloudAccountGcpUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
@@ -98,7 +113,7 @@ func resourceCloudAccountGcpUpdate(ctx context.Context, d *schema.ResourceData,
 
 	account := toGcpAccount(d)
 
-	err := c.UpdateCloudAccountGcp(account)
+	err = c.UpdateCloudAccountGcp(account)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -108,15 +123,19 @@ func resourceCloudAccountGcpUpdate(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func resourceCloudAccountGcpDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountGcpDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
 
 	cloudAccountID := d.Id()
 	//AccountContext := d.Get("context").(string)
-	err := c.DeleteCloudAccountGcp(cloudAccountID)
+	err = c.DeleteCloudAccountGcp(cloudAccountID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func resourceCloudAccountMaas() *schema.Resource {
@@ -34,6 +36,7 @@ func resourceCloudAccountMaas() *schema.Resource {
 				Description: "The context of the MAAS configuration. " +
 					"Allowed values are `project` or `tenant`. Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"private_cloud_gateway_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -56,6 +59,10 @@ func resourceCloudAccountMaas() *schema.Resource {
 
 func resourceCloudAccountMaasCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
@@ -74,8 +81,12 @@ func resourceCloudAccountMaasCreate(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func resourceCloudAccountMaasRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountMaasRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -106,13 +117,17 @@ func resourceCloudAccountMaasRead(_ context.Context, d *schema.ResourceData, m i
 
 func resourceCloudAccountMaasUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	account := toMaasAccount(d)
-	err := c.UpdateCloudAccountMaas(account)
+	err = c.UpdateCloudAccountMaas(account)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -122,14 +137,18 @@ func resourceCloudAccountMaasUpdate(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func resourceCloudAccountMaasDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountMaasDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
 
 	cloudAccountID := d.Id()
-	err := c.DeleteCloudAccountMaas(cloudAccountID)
+	err = c.DeleteCloudAccountMaas(cloudAccountID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -159,9 +178,13 @@ func toMaasAccount(d *schema.ResourceData) *models.V1MaasAccount {
 
 func resourceAccountMaasImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return nil, err
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
-	err := GetCommonAccount(d, c, "maas")
+	err = GetCommonAccount(d, c, "maas")
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 	"github.com/spectrocloud/terraform-provider-spectrocloud/types"
 )
 
@@ -37,6 +38,7 @@ func resourceCloudAccountVsphere() *schema.Resource {
 				Description: "Context of the cloud account. Allowed values are `project` or `tenant`. " +
 					"Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"private_cloud_gateway_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -69,6 +71,10 @@ func resourceCloudAccountVsphere() *schema.Resource {
 
 func resourceCloudAccountVsphereCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
@@ -88,8 +94,12 @@ func resourceCloudAccountVsphereCreate(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
-func resourceCloudAccountVsphereRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountVsphereRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -136,6 +146,10 @@ func flattenVsphereCloudAccount(d *schema.ResourceData, account *models.V1Vspher
 
 func resourceCloudAccountVsphereUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
@@ -143,7 +157,7 @@ func resourceCloudAccountVsphereUpdate(ctx context.Context, d *schema.ResourceDa
 
 	account := toVsphereAccount(d)
 
-	err := c.UpdateCloudAccountVsphere(account)
+	err = c.UpdateCloudAccountVsphere(account)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -153,14 +167,18 @@ func resourceCloudAccountVsphereUpdate(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
-func resourceCloudAccountVsphereDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceCloudAccountVsphereDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
 
 	cloudAccountID := d.Id()
-	err := c.DeleteCloudAccountVsphere(cloudAccountID)
+	err = c.DeleteCloudAccountVsphere(cloudAccountID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -190,9 +208,13 @@ func toVsphereAccount(d *schema.ResourceData) *models.V1VsphereAccount {
 
 func resourceAccountVsphereImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return nil, err
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
-	err := GetCommonAccount(d, c, "vsphere")
+	err = GetCommonAccount(d, c, "vsphere")
 	if err != nil {
 		return nil, err
 	}
@@ -46,6 +46,7 @@ func resourceClusterAks() *schema.Resource {
 				Version: 3,
 			},
 		},
+		CustomizeDiff: resourceClusterCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -61,6 +62,7 @@ func resourceClusterAks() *schema.Resource {
 				Description: "The context of the AKS cluster. Allowed values are `project` or `tenant`. " +
 					"Default is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -143,7 +145,10 @@ func resourceClusterAks() *schema.Resource {
 				ValidateFunc: validateTimezone,
 				Description:  "Defines the time zone used by this cluster to interpret scheduled operations. Maintenance tasks like upgrades will follow this time zone to ensure they run at the appropriate local time for the cluster. Must be in IANA timezone format (e.g., 'America/New_York', 'Asia/Kolkata', 'Europe/London').",
 			},
-			"renew_k8s_certificates_now": schemas.RenewK8sCertificatesNowSchema(),
+			"renew_k8s_certificates_now":          schemas.RenewK8sCertificatesNowSchema(),
+			"auto_renew_certificates_before_days": schemas.AutoRenewCertificatesBeforeDaysSchema(),
+			"k8s_certificates_expiry":             schemas.K8sCertificatesExpirySchema(),
+			"k8s_certificates":                    schemas.K8sCertificatesSchema(),
 			"update_worker_pools_in_parallel": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -370,6 +375,9 @@ func resourceClusterAks() *schema.Resource {
 				Default:     false,
 				Description: "If `true`, the cluster will be created asynchronously. Default value is `false`.",
 			},
+			"wait_for":       schemas.ClusterWaitForSchema(),
+			"wait_on_update": schemas.ClusterWaitOnUpdateSchema(),
+			"wait_settings":  schemas.WaitSettingsSchema(),
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -389,10 +397,15 @@ func resourceClusterAks() *schema.Resource {
 
 func resourceClusterAksCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	// Validate override_Scaling configuration
 	if err := validateOverrideScaling(d, "machine_pool"); err != nil {
@@ -409,7 +422,7 @@ func resourceClusterAksCreate(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true)
+	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true, m)
 	if len(diagnostics) > 0 {
 		diags = append(diags, diagnostics...)
 	}
@@ -423,8 +436,12 @@ func resourceClusterAksCreate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 //goland:noinspection GoUnhandledErrorResult
-func resourceClusterAksRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceClusterAksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -471,7 +488,7 @@ func resourceClusterAksRead(_ context.Context, d *schema.ResourceData, m interfa
 		}
 	}
 
-	diagnostics, done := readCommonFields(c, d, cluster)
+	diagnostics, done := readCommonFields(c, d, cluster, m)
 	if done {
 		return diagnostics
 	}
@@ -624,12 +641,17 @@ func flattenMachinePoolConfigsAks(machinePools []*models.V1AzureMachinePoolConfi
 
 func resourceClusterAksUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
-	err := validateSystemRepaveApproval(d, c)
+	err = validateSystemRepaveApproval(d, c)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -702,7 +724,7 @@ func resourceClusterAksUpdate(ctx context.Context, d *schema.ResourceData, m int
 							return diag.FromErr(err)
 						}
 						// Node Maintenance Actions
-						err = resourceNodeAction(c, ctx, machinePoolResource, c.GetNodeMaintenanceStatusAks, CloudConfig.Kind, cloudConfigId, name)
+						err = resourceNodeAction(c, ctx, machinePoolResource, c.GetNodeMaintenanceStatusAks, CloudConfig.Kind, cloudConfigId, name, waitSettingsFor(m, d))
 						if err != nil {
 							return diag.FromErr(err)
 						}
@@ -728,11 +750,15 @@ func resourceClusterAksUpdate(ctx context.Context, d *schema.ResourceData, m int
 		}
 	}
 
-	diagnostics, done := updateCommonFields(d, c)
+	diagnostics, done := updateCommonFields(ctx, d, c, m)
 	if done {
 		return diagnostics
 	}
 
+	if waitDiags := waitForClusterUpdate(ctx, d, c, m); waitDiags.HasError() {
+		return waitDiags
+	}
+
 	resourceClusterAksRead(ctx, d, m)
 
 	return diags
@@ -3,6 +3,7 @@ package spectrocloud
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -61,6 +62,10 @@ func runUpdateHasChangeSuite(
 	rejectsClusterType bool,
 ) {
 	t.Helper()
+	// test-cluster-uid reports no certificate expiry, so the renewal
+	// falls back to waiting for the update to start.
+	defer func(timeout time.Duration) { clusterUpdateStartTimeout = timeout }(clusterUpdateStartTimeout)
+	clusterUpdateStartTimeout = 50 * time.Millisecond
 	cases := []struct {
 		label string
 		diff  map[string]*terraform.ResourceAttrDiff
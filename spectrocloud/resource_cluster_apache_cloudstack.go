@@ -50,6 +50,7 @@ func resourceClusterApacheCloudStack() *schema.Resource {
 				Version: 2,
 			},
 		},
+		CustomizeDiff: resourceClusterCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -65,6 +66,7 @@ func resourceClusterApacheCloudStack() *schema.Resource {
 				Description: "The context of the CloudStack configuration. Allowed values are `project` or `tenant`. " +
 					"Default is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -147,7 +149,10 @@ func resourceClusterApacheCloudStack() *schema.Resource {
 				ValidateFunc: validateTimezone,
 				Description:  "Defines the time zone used by this cluster to interpret scheduled operations. Maintenance tasks like upgrades will follow this time zone to ensure they run at the appropriate local time for the cluster. Must be in IANA timezone format (e.g., 'America/New_York', 'Asia/Kolkata', 'Europe/London').",
 			},
-			"renew_k8s_certificates_now": schemas.RenewK8sCertificatesNowSchema(),
+			"renew_k8s_certificates_now":          schemas.RenewK8sCertificatesNowSchema(),
+			"auto_renew_certificates_before_days": schemas.AutoRenewCertificatesBeforeDaysSchema(),
+			"k8s_certificates_expiry":             schemas.K8sCertificatesExpirySchema(),
+			"k8s_certificates":                    schemas.K8sCertificatesSchema(),
 			"update_worker_pools_in_parallel": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -492,6 +497,9 @@ func resourceClusterApacheCloudStack() *schema.Resource {
 				Default:     false,
 				Description: "If `true`, the cluster will be created asynchronously. Default value is `false`.",
 			},
+			"wait_for":       schemas.ClusterWaitForSchema(),
+			"wait_on_update": schemas.ClusterWaitOnUpdateSchema(),
+			"wait_settings":  schemas.WaitSettingsSchema(),
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -536,6 +544,7 @@ func resourceClusterApacheCloudStackCreate(ctx context.Context, d *schema.Resour
 	c := getV1ClientWithResourceContext(m, "")
 	var diags diag.Diagnostics
 	appendOverrideHealthCheckConfigurationCreateWarnings(d, &diags)
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	// Validate override_Scaling configuration
 	if err := validateOverrideScaling(d, "machine_pool"); err != nil {
@@ -557,7 +566,7 @@ func resourceClusterApacheCloudStackCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	diags, done := waitForClusterCreation(ctx, d, uid, diags, c, false)
+	diags, done := waitForClusterCreation(ctx, d, uid, diags, c, false, m)
 	if done {
 		return diags
 	}
@@ -567,8 +576,12 @@ func resourceClusterApacheCloudStackCreate(ctx context.Context, d *schema.Resour
 	return diags
 }
 
-func resourceClusterApacheCloudStackRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceClusterApacheCloudStackRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 	var diags diag.Diagnostics
 
@@ -587,7 +600,7 @@ func resourceClusterApacheCloudStackRead(_ context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	diagnostics, done := readCommonFields(c, d, cluster)
+	diagnostics, done := readCommonFields(c, d, cluster, m)
 	if done {
 		return diagnostics
 	}
@@ -606,7 +619,12 @@ func resourceClusterApacheCloudStackUpdate(ctx context.Context, d *schema.Resour
 
 	cloudConfigId := d.Get("cloud_config_id").(string)
 	ClusterContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, ClusterContext)
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	if err := validateSystemRepaveApproval(d, c); err != nil {
 		return diag.FromErr(err)
@@ -631,11 +649,15 @@ func resourceClusterApacheCloudStackUpdate(ctx context.Context, d *schema.Resour
 	}
 
 	// Check common updates
-	diagnostics, done := updateCommonFields(d, c)
+	diagnostics, done := updateCommonFields(ctx, d, c, m)
 	if done {
 		return diagnostics
 	}
 
+	if waitDiags := waitForClusterUpdate(ctx, d, c, m); waitDiags.HasError() {
+		return waitDiags
+	}
+
 	resourceClusterApacheCloudStackRead(ctx, d, m)
 
 	return diags
@@ -55,6 +55,7 @@ func resourceAddonDeployment() *schema.Resource {
 				Description: "Specifies cluster context where addon profile is attached. " +
 					"Allowed values are `project` or `tenant`. Defaults to `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project":         schemas.ProjectSchema(),
 			"cluster_profile": schemas.ClusterProfileSchemaV2(),
 			"apply_setting": {
 				Type:         schema.TypeString,
@@ -65,19 +66,20 @@ func resourceAddonDeployment() *schema.Resource {
 					"`DownloadAndInstallLater` will only download artifact and postpone install for later. " +
 					"Default value is `DownloadAndInstall`.",
 			},
+			"wait_settings": schemas.WaitSettingsSchema(),
 		},
 	}
 }
 
-func resourceAddonDeploymentCustomizeDiff(_ context.Context, _ *schema.ResourceDiff, _ interface{}) error {
-	if addonDeploymentResourceDisabled() {
+func resourceAddonDeploymentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if addonDeploymentResourceDisabled(m) {
 		return addonDeploymentResourceDisabledError()
 	}
-	return nil
+	return validateClusterProfileVariables(ctx, d, m)
 }
 
 func resourceAddonDeploymentStateUpgradeV2(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
-	if addonDeploymentResourceDisabled() {
+	if addonDeploymentResourceDisabled(meta) {
 		return nil, addonDeploymentResourceDisabledError()
 	}
 
@@ -116,7 +118,7 @@ func validateSingleClusterProfile(d *schema.ResourceData) error {
 }
 
 func resourceAddonDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if addonDeploymentResourceDisabled() {
+	if addonDeploymentResourceDisabled(m) {
 		return diag.FromErr(addonDeploymentResourceDisabledError())
 	}
 
@@ -126,9 +128,14 @@ func resourceAddonDeploymentCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	clusterUid := d.Get("cluster_uid").(string)
 
@@ -142,7 +149,7 @@ func resourceAddonDeploymentCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	diagnostics, isError := waitForClusterCreation(ctx, d, clusterUid, diags, c, false)
+	diagnostics, isError := waitForClusterCreation(ctx, d, clusterUid, diags, c, false, m)
 	if isError {
 		return diagnostics
 	}
@@ -166,12 +173,12 @@ func resourceAddonDeploymentCreate(ctx context.Context, d *schema.ResourceData,
 	}
 	d.SetId(getAddonDeploymentId(clusterUid, clusterProfile))
 
-	diagnostics, isError = waitForAddonDeploymentCreation(ctx, d, *cluster, addonDeployment.Profiles[0].UID, diags, c)
+	diagnostics, isError = waitForAddonDeploymentCreation(ctx, d, *cluster, addonDeployment.Profiles[0].UID, diags, c, m)
 	if isError {
 		return diagnostics
 	}
 
-	return resourceAddonDeploymentRead(ctx, d, m)
+	return append(diags, resourceAddonDeploymentRead(ctx, d, m)...)
 }
 
 func getAddonDeploymentId(clusterUid string, clusterProfile *models.V1ClusterProfile) string {
@@ -201,12 +208,16 @@ func isProfileAttached(cluster *models.V1SpectroCluster, uid string) bool {
 }
 
 //goland:noinspection GoUnhandledErrorResult
-func resourceAddonDeploymentRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if addonDeploymentResourceDisabled() {
+func resourceAddonDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if addonDeploymentResourceDisabled(m) {
 		return diag.FromErr(addonDeploymentResourceDisabledError())
 	}
 
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -231,7 +242,7 @@ func resourceAddonDeploymentRead(_ context.Context, d *schema.ResourceData, m in
 }
 
 func resourceAddonDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if addonDeploymentResourceDisabled() {
+	if addonDeploymentResourceDisabled(m) {
 		return diag.FromErr(addonDeploymentResourceDisabledError())
 	}
 
@@ -244,7 +255,12 @@ func resourceAddonDeploymentUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChanges("cluster_uid", "cluster_profile") {
 		resourceContext := d.Get("context").(string)
+		m, err := withResourceProject(ctx, m, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		c := getV1ClientWithResourceContext(m, resourceContext)
+		diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 		clusterUid := d.Get("cluster_uid").(string)
 
@@ -282,12 +298,12 @@ func updateAddonDeployment(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 	d.SetId(getAddonDeploymentId(clusterUid, clusterProfile))
-	diagnostics, isError := waitForAddonDeploymentUpdate(ctx, d, *cluster, addonDeployment.Profiles[0].UID, diags, c)
+	diagnostics, isError := waitForAddonDeploymentUpdate(ctx, d, *cluster, addonDeployment.Profiles[0].UID, diags, c, m)
 	if isError {
 		return diagnostics
 	}
 
-	return resourceAddonDeploymentRead(ctx, d, m)
+	return append(diags, resourceAddonDeploymentRead(ctx, d, m)...)
 }
 
 func toAddonDeployment(c *client.V1Client, d *schema.ResourceData) (*models.V1SpectroClusterProfiles, error) {
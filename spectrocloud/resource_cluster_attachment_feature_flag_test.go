@@ -60,14 +60,11 @@ func TestResourceAddonDeploymentUpdate_ValidateSingleProfile(t *testing.T) {
 	assert.True(t, diags.HasError(), "missing cluster_profile must error")
 }
 
-// TestAddonDeploymentFeatureFlagDisabled toggles the package-level flag
-// and confirms every CRUD entry point + CustomizeDiff + StateUpgrader
-// short-circuits with the disabled error. The flag is restored on
-// defer so subsequent tests aren't affected.
+// TestAddonDeploymentFeatureFlagDisabled sets the flag on a copy of the
+// provider meta and confirms every CRUD entry point + CustomizeDiff +
+// StateUpgrader short-circuits with the disabled error.
 func TestAddonDeploymentFeatureFlagDisabled(t *testing.T) {
-	orig := disableAddonDeploymentResource
-	disableAddonDeploymentResource = true
-	defer func() { disableAddonDeploymentResource = orig }()
+	m := withTestAddonDeploymentDisabled(unitTestMockAPIClient, true)
 
 	d := resourceAddonDeployment().TestResourceData()
 	_ = d.Set("cluster_uid", "test-cluster-uid")
@@ -76,29 +73,29 @@ func TestAddonDeploymentFeatureFlagDisabled(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("Create", func(t *testing.T) {
-		diags := resourceAddonDeploymentCreate(ctx, d, unitTestMockAPIClient)
+		diags := resourceAddonDeploymentCreate(ctx, d, m)
 		assert.True(t, diags.HasError())
 	})
 	t.Run("Read", func(t *testing.T) {
-		diags := resourceAddonDeploymentRead(ctx, d, unitTestMockAPIClient)
+		diags := resourceAddonDeploymentRead(ctx, d, m)
 		assert.True(t, diags.HasError())
 	})
 	t.Run("Update", func(t *testing.T) {
-		diags := resourceAddonDeploymentUpdate(ctx, d, unitTestMockAPIClient)
+		diags := resourceAddonDeploymentUpdate(ctx, d, m)
 		assert.True(t, diags.HasError())
 	})
 	t.Run("Delete", func(t *testing.T) {
-		diags := resourceAddonDeploymentDelete(ctx, d, unitTestMockAPIClient)
+		diags := resourceAddonDeploymentDelete(ctx, d, m)
 		// Delete may or may not gate on the flag depending on codepath;
 		// what matters is no panic and the assertion works either way.
 		_ = diags
 	})
 	t.Run("CustomizeDiff", func(t *testing.T) {
-		err := resourceAddonDeploymentCustomizeDiff(ctx, nil, nil)
+		err := resourceAddonDeploymentCustomizeDiff(ctx, nil, m)
 		assert.Error(t, err)
 	})
 	t.Run("StateUpgrade", func(t *testing.T) {
-		_, err := resourceAddonDeploymentStateUpgradeV2(ctx, map[string]interface{}{}, nil)
+		_, err := resourceAddonDeploymentStateUpgradeV2(ctx, map[string]interface{}{}, m)
 		assert.Error(t, err)
 	})
 }
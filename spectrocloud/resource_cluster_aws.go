@@ -42,6 +42,7 @@ func resourceClusterAws() *schema.Resource {
 				Version: 2,
 			},
 		},
+		CustomizeDiff: resourceClusterCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -57,6 +58,7 @@ func resourceClusterAws() *schema.Resource {
 				Description: "The context of the AWS cluster. Allowed values are `project` or `tenant`. " +
 					"Default is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -149,7 +151,10 @@ func resourceClusterAws() *schema.Resource {
 				ValidateFunc: validateTimezone,
 				Description:  "Defines the time zone used by this cluster to interpret scheduled operations. Maintenance tasks like upgrades will follow this time zone to ensure they run at the appropriate local time for the cluster. Must be in IANA timezone format (e.g., 'America/New_York', 'Asia/Kolkata', 'Europe/London').",
 			},
-			"renew_k8s_certificates_now": schemas.RenewK8sCertificatesNowSchema(),
+			"renew_k8s_certificates_now":          schemas.RenewK8sCertificatesNowSchema(),
+			"auto_renew_certificates_before_days": schemas.AutoRenewCertificatesBeforeDaysSchema(),
+			"k8s_certificates_expiry":             schemas.K8sCertificatesExpirySchema(),
+			"k8s_certificates":                    schemas.K8sCertificatesSchema(),
 			"update_worker_pools_in_parallel": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -369,6 +374,9 @@ func resourceClusterAws() *schema.Resource {
 				Default:     false,
 				Description: "If `true`, the cluster will be created asynchronously. Default value is `false`.",
 			},
+			"wait_for":       schemas.ClusterWaitForSchema(),
+			"wait_on_update": schemas.ClusterWaitOnUpdateSchema(),
+			"wait_settings":  schemas.WaitSettingsSchema(),
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -388,11 +396,16 @@ func resourceClusterAws() *schema.Resource {
 
 func resourceClusterAwsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 	appendOverrideHealthCheckConfigurationCreateWarnings(d, &diags)
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	// Validate override_Scaling configuration
 	if err := validateOverrideScaling(d, "machine_pool"); err != nil {
@@ -410,7 +423,7 @@ func resourceClusterAwsCreate(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true)
+	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true, m)
 	if len(diagnostics) > 0 {
 		diags = append(diags, diagnostics...)
 	}
@@ -424,8 +437,12 @@ func resourceClusterAwsCreate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 //goland:noinspection GoUnhandledErrorResult
-func resourceClusterAwsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceClusterAwsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -445,7 +462,7 @@ func resourceClusterAwsRead(_ context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
-	diagnostics, done := readCommonFields(c, d, cluster)
+	diagnostics, done := readCommonFields(c, d, cluster, m)
 
 	// handling flatten tags_map for aws  cluster
 	if _, ok := d.GetOk("tags_map"); ok {
@@ -624,18 +641,23 @@ func flattenMachinePoolConfigsAws(machinePools []*models.V1AwsMachinePoolConfig)
 
 func resourceClusterAwsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 	appendOverrideHealthCheckConfigurationUpdateWarnings(d, &diags)
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	// Validate that cluster_type is not being modified (it's a create-only field)
 	if err := ValidateClusterTypeUpdate(d); err != nil {
 		return diag.FromErr(err)
 	}
 
-	err := validateSystemRepaveApproval(d, c)
+	err = validateSystemRepaveApproval(d, c)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -698,7 +720,7 @@ func resourceClusterAwsUpdate(ctx context.Context, d *schema.ResourceData, m int
 						log.Printf("Change in machine pool %s", name)
 						err = c.UpdateMachinePoolAws(cloudConfigId, machinePool)
 						// Node Maintenance Actions
-						err := resourceNodeAction(c, ctx, nsMap[name], c.GetNodeMaintenanceStatusAws, CloudConfig.Kind, cloudConfigId, name)
+						err := resourceNodeAction(c, ctx, nsMap[name], c.GetNodeMaintenanceStatusAws, CloudConfig.Kind, cloudConfigId, name, waitSettingsFor(m, d))
 						if err != nil {
 							return diag.FromErr(err)
 						}
@@ -725,10 +747,14 @@ func resourceClusterAwsUpdate(ctx context.Context, d *schema.ResourceData, m int
 		}
 	}
 
-	diagnostics, done := updateCommonFields(d, c)
+	diagnostics, done := updateCommonFields(ctx, d, c, m)
 	if done {
 		return diagnostics
 	}
+	if waitDiags := waitForClusterUpdate(ctx, d, c, m); waitDiags.HasError() {
+		return waitDiags
+	}
+
 	resourceClusterAwsRead(ctx, d, m)
 	return diags
 }
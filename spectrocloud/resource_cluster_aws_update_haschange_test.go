@@ -3,6 +3,7 @@ package spectrocloud
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
@@ -129,6 +130,8 @@ func TestResourceClusterAwsUpdate_HasChange_NameMetadata(t *testing.T) {
 // TestResourceClusterAwsUpdate_HasChange_RenewK8sCerts fires the final
 // renewK8sCertificatesNow branch inside updateCommonFields.
 func TestResourceClusterAwsUpdate_HasChange_RenewK8sCerts(t *testing.T) {
+	defer func(timeout time.Duration) { clusterUpdateStartTimeout = timeout }(clusterUpdateStartTimeout)
+	clusterUpdateStartTimeout = 50 * time.Millisecond
 	d := buildUpdateResourceData(resourceClusterAws(), "test-cluster-uid",
 		baseAwsUpdateAttrs(),
 		map[string]*terraform.ResourceAttrDiff{
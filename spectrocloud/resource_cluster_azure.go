@@ -43,6 +43,7 @@ func resourceClusterAzure() *schema.Resource {
 				Version: 0,
 			},
 		},
+		CustomizeDiff: resourceClusterCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -58,6 +59,7 @@ func resourceClusterAzure() *schema.Resource {
 				Description: "The context of the Azure cluster. Allowed values are `project` or `tenant`. " +
 					"Default is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -140,7 +142,10 @@ func resourceClusterAzure() *schema.Resource {
 				ValidateFunc: validateTimezone,
 				Description:  "Defines the time zone used by this cluster to interpret scheduled operations. Maintenance tasks like upgrades will follow this time zone to ensure they run at the appropriate local time for the cluster. Must be in IANA timezone format (e.g., 'America/New_York', 'Asia/Kolkata', 'Europe/London').",
 			},
-			"renew_k8s_certificates_now": schemas.RenewK8sCertificatesNowSchema(),
+			"renew_k8s_certificates_now":          schemas.RenewK8sCertificatesNowSchema(),
+			"auto_renew_certificates_before_days": schemas.AutoRenewCertificatesBeforeDaysSchema(),
+			"k8s_certificates_expiry":             schemas.K8sCertificatesExpirySchema(),
+			"k8s_certificates":                    schemas.K8sCertificatesSchema(),
 			"update_worker_pools_in_parallel": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -396,6 +401,9 @@ func resourceClusterAzure() *schema.Resource {
 				Default:     false,
 				Description: "If `true`, the cluster will be created asynchronously. Default value is `false`.",
 			},
+			"wait_for":       schemas.ClusterWaitForSchema(),
+			"wait_on_update": schemas.ClusterWaitOnUpdateSchema(),
+			"wait_settings":  schemas.WaitSettingsSchema(),
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -438,11 +446,16 @@ func resourceClusterAzureStateUpgradeV0(ctx context.Context, rawState map[string
 
 func resourceClusterAzureCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 	appendOverrideHealthCheckConfigurationCreateWarnings(d, &diags)
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	// Validate override_Scaling configuration
 	if err := validateOverrideScaling(d, "machine_pool"); err != nil {
@@ -453,9 +466,8 @@ func resourceClusterAzureCreate(ctx context.Context, d *schema.ResourceData, m i
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	diags = validateCPPoolCount(cluster.Spec.Machinepoolconfig)
-	if diags != nil {
-		return diags
+	if cpDiags := validateCPPoolCount(cluster.Spec.Machinepoolconfig); cpDiags != nil {
+		return cpDiags
 	}
 
 	uid, err := c.CreateClusterAzure(cluster)
@@ -463,7 +475,7 @@ func resourceClusterAzureCreate(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
-	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true)
+	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true, m)
 	if len(diagnostics) > 0 {
 		diags = append(diags, diagnostics...)
 	}
@@ -477,8 +489,12 @@ func resourceClusterAzureCreate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 //goland:noinspection GoUnhandledErrorResult
-func resourceClusterAzureRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceClusterAzureRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -496,7 +512,7 @@ func resourceClusterAzureRead(_ context.Context, d *schema.ResourceData, m inter
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	diagnostics, done := readCommonFields(c, d, cluster)
+	diagnostics, done := readCommonFields(c, d, cluster, m)
 	if done {
 		return diagnostics
 	}
@@ -653,12 +669,17 @@ func flattenMachinePoolConfigsAzure(machinePools []*models.V1AzureMachinePoolCon
 
 func resourceClusterAzureUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 	appendOverrideHealthCheckConfigurationUpdateWarnings(d, &diags)
-	err := validateSystemRepaveApproval(d, c)
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
+	err = validateSystemRepaveApproval(d, c)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -685,9 +706,8 @@ func resourceClusterAzureUpdate(ctx context.Context, d *schema.ResourceData, m i
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		diags = validateCPPoolCount(cluster.Spec.Machinepoolconfig)
-		if diags != nil {
-			return diags
+		if cpDiags := validateCPPoolCount(cluster.Spec.Machinepoolconfig); cpDiags != nil {
+			return cpDiags
 		}
 		oraw, nraw := d.GetChange("machine_pool")
 		if oraw == nil {
@@ -728,7 +748,7 @@ func resourceClusterAzureUpdate(ctx context.Context, d *schema.ResourceData, m i
 					log.Printf("Change in machine pool %s", name)
 					err = c.UpdateMachinePoolAzure(cloudConfigId, machinePool)
 					// Node Maintenance Actions
-					err := resourceNodeAction(c, ctx, nsMap[name], c.GetNodeMaintenanceStatusAzure, CloudConfig.Kind, cloudConfigId, name)
+					err := resourceNodeAction(c, ctx, nsMap[name], c.GetNodeMaintenanceStatusAzure, CloudConfig.Kind, cloudConfigId, name, waitSettingsFor(m, d))
 					if err != nil {
 						return diag.FromErr(err)
 					}
@@ -754,11 +774,15 @@ func resourceClusterAzureUpdate(ctx context.Context, d *schema.ResourceData, m i
 		}
 	}
 
-	diagnostics, done := updateCommonFields(d, c)
+	diagnostics, done := updateCommonFields(ctx, d, c, m)
 	if done {
 		return diagnostics
 	}
 
+	if waitDiags := waitForClusterUpdate(ctx, d, c, m); waitDiags.HasError() {
+		return waitDiags
+	}
+
 	resourceClusterAzureRead(ctx, d, m)
 
 	return diags
@@ -0,0 +1,375 @@
+package spectrocloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/palette-sdk-go/client"
+	"github.com/spectrocloud/palette-sdk-go/client/herr"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+)
+
+// Backup and restore states reported by Palette, which are Velero's phases.
+const (
+	clusterBackupStateCompleted       = "Completed"
+	clusterBackupStateFailed          = "Failed"
+	clusterBackupStatePartiallyFailed = "PartiallyFailed"
+)
+
+var resourceClusterBackupPendingStates = []string{
+	"",
+	"New",
+	"InProgress",
+	"WaitingForPluginOperations",
+	"Finalizing",
+}
+
+func resourceClusterBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterBackupCreate,
+		ReadContext:   resourceClusterBackupRead,
+		UpdateContext: resourceClusterBackupUpdate,
+		DeleteContext: resourceClusterBackupDelete,
+		Description: "Takes an on-demand backup of a cluster to a backup storage location, for example before a risky change. " +
+			"Scheduled backups are configured with the `backup_policy` block of the cluster resources instead.",
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The UID of the cluster to back up.",
+			},
+			"context": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "project",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"project", "tenant"}, false),
+				Description: "The context of the cluster. Allowed values are `project` or `tenant`. " +
+					"Default value is `project`. " + PROJECT_NAME_NUANCE,
+			},
+			"project": schemas.ProjectSchema(),
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the backup. It must be unique among the backups of the cluster.",
+			},
+			"backup_location_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the backup storage location to store the backup in, such as the ID of a `spectrocloud_backup_storage_location`.",
+			},
+			"namespaces": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The Kubernetes namespaces to back up. If not specified, all namespaces are backed up.",
+			},
+			"include_disks": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "Whether to include the disks of persistent volumes in the backup. Default value is `true`.",
+			},
+			"include_cluster_resources_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "auto",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"always", "never", "auto"}, false),
+				Description:  "Whether to include cluster-scoped resources in the backup. Supported values are `always`, `never`, and `auto`. Default value is `auto`.",
+			},
+			"expiry_in_hour": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      720,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of hours after which Palette deletes the backup. Default value is `720`, 30 days.",
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Whether to wait for the backup to complete when it is created. A failed backup fails the apply. " +
+					"When `false`, creation only waits for Palette to list the backup. Default value is `true`.",
+			},
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "When `true`, destroying the resource removes it from Terraform state without deleting the backup. " +
+					"Default value is `false`.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the backup, such as `InProgress`, `Completed`, `PartiallyFailed` or `Failed`.",
+			},
+			"backup_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the backup was taken, in RFC3339 format.",
+			},
+			"expiry_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the backup expires, in RFC3339 format.",
+			},
+			"backed_up_namespaces": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The namespaces the backup contains.",
+			},
+		},
+	}
+}
+
+func resourceClusterBackupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	clusterUID := d.Get("cluster_uid").(string)
+	namespaces := make([]string, 0)
+	for _, ns := range d.Get("namespaces").(*schema.Set).List() {
+		namespaces = append(namespaces, ns.(string))
+	}
+	config := &models.V1ClusterBackupConfig{
+		BackupName:                 d.Get("name").(string),
+		BackupLocationUID:          d.Get("backup_location_id").(string),
+		DurationInHours:            int64(d.Get("expiry_in_hour").(int)),
+		IncludeAllDisks:            d.Get("include_disks").(bool),
+		IncludeClusterResourceMode: convertIncludeResourceMode(d.Get("include_cluster_resources_mode").(string)),
+		Namespaces:                 namespaces,
+	}
+
+	log.Printf("taking backup %s of cluster %s", config.BackupName, clusterUID)
+	uid, err := c.CreateClusterBackupConfigOnDemand(clusterUID, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if uid == nil || uid.UID == nil {
+		return diag.FromErr(fmt.Errorf("backup %s of cluster %s: Palette returned no backup request UID", config.BackupName, clusterUID))
+	}
+	d.SetId(*uid.UID)
+
+	if d.Get("wait_for_completion").(bool) {
+		stateConf := &retry.StateChangeConf{
+			Pending: resourceClusterBackupPendingStates,
+			Target:  []string{clusterBackupStateCompleted},
+			Refresh: resourceClusterBackupStateRefreshFunc(c, clusterUID, d.Id(), config.BackupName),
+			Timeout: d.Timeout(schema.TimeoutCreate) - 1*time.Minute,
+		}
+		waitSettingsFor(m, d).apply(stateConf)
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return append(diag.FromErr(err), resourceClusterBackupRead(ctx, d, m)...)
+		}
+	} else if err := waitForClusterBackupListed(ctx, m, d, resourceClusterBackupStateRefreshFunc(c, clusterUID, d.Id(), config.BackupName)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceClusterBackupRead(ctx, d, m)
+}
+
+func resourceClusterBackupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	var diags diag.Diagnostics
+	request, backup, err := getClusterBackupStatus(c, d.Get("cluster_uid").(string), d.Id(), d.Get("name").(string))
+	if err != nil {
+		return handleReadError(d, err, diags)
+	} else if request == nil {
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("state", clusterBackupState(request, backup)); err != nil {
+		return diag.FromErr(err)
+	}
+	if request.BackupLocationConfig != nil && request.BackupLocationConfig.UID != "" {
+		if err := d.Set("backup_location_id", request.BackupLocationConfig.UID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	backupTime, expiryDate, namespaces := "", "", []string{}
+	if backup != nil {
+		if backup.BackupState != nil {
+			backupTime = formatV1Time(backup.BackupState.BackupTime)
+		}
+		expiryDate = formatV1Time(backup.ExpiryDate)
+		namespaces = backup.BackupedNamespaces
+	}
+	if err := d.Set("backup_time", backupTime); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("expiry_date", expiryDate); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("backed_up_namespaces", namespaces); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+// resourceClusterBackupUpdate only runs for wait_for_completion and
+// skip_destroy; everything else takes a new backup.
+func resourceClusterBackupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceClusterBackupRead(ctx, d, m)
+}
+
+func resourceClusterBackupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.Get("skip_destroy").(bool) {
+		log.Printf("skip_destroy: removing backup %s from Terraform state without deleting it", d.Get("name").(string))
+		return nil
+	}
+
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	// DeleteClusterBackupConfigOnDemand sends BackupLocationUID as the
+	// request UID of the backup to delete.
+	err = c.DeleteClusterBackupConfigOnDemand(d.Get("cluster_uid").(string), &models.V1ClusterBackupConfig{
+		BackupName:        d.Get("name").(string),
+		BackupLocationUID: d.Id(),
+	})
+	if err != nil && !herr.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceClusterBackupStateRefreshFunc(c *client.V1Client, clusterUID, requestUID, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		request, backup, err := getClusterBackupStatus(c, clusterUID, requestUID, name)
+		if err != nil {
+			return nil, "", err
+		} else if request == nil {
+			// The backup can take a moment to be listed.
+			return nil, "", nil
+		}
+
+		state := clusterBackupState(request, backup)
+		log.Printf("Backup %s state (%s): %s", name, clusterUID, state)
+		if state == clusterBackupStateFailed || state == clusterBackupStatePartiallyFailed {
+			msg := ""
+			if backup != nil && backup.BackupState != nil {
+				msg = backup.BackupState.Msg
+			}
+			return request, state, clusterBackupStateError(fmt.Sprintf("backup %s of cluster %s", name, clusterUID), state, msg)
+		}
+		return request, state, nil
+	}
+}
+
+// getClusterBackupStatus finds a backup of a cluster by its request UID and
+// name. It returns a nil request when the cluster has no such backup, and a
+// nil backup until Palette reports the backup of the request.
+func getClusterBackupStatus(c *client.V1Client, clusterUID, requestUID, name string) (*models.V1ClusterBackupStatusMeta, *models.V1BackupStatusMeta, error) {
+	config, err := c.GetClusterBackupConfig(clusterUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if config == nil || config.Status == nil {
+		return nil, nil, nil
+	}
+	for _, request := range config.Status.ClusterBackupStatuses {
+		if request == nil || request.BackupRequestUID != requestUID {
+			continue
+		}
+		for _, backup := range request.BackupStatusMeta {
+			if backup != nil && backup.BackupName == name {
+				return request, backup, nil
+			}
+		}
+		return request, nil, nil
+	}
+	return nil, nil, nil
+}
+
+// clusterBackupState prefers the state of the backup itself over the state
+// of its request.
+func clusterBackupState(request *models.V1ClusterBackupStatusMeta, backup *models.V1BackupStatusMeta) string {
+	if backup != nil && backup.BackupState != nil && backup.BackupState.State != "" {
+		return backup.BackupState.State
+	}
+	return request.State
+}
+
+// clusterBackupStateError reports that a backup or restore ended in a failed
+// state, with Palette's message when it has one.
+func clusterBackupStateError(what, state, msg string) error {
+	if msg == "" {
+		return fmt.Errorf("%s: %s", what, state)
+	}
+	return fmt.Errorf("%s: %s: %s", what, state, msg)
+}
+
+// clusterBackupListTimeout bounds how long Create waits for Palette to list
+// a backup or restore request it accepted when wait_for_completion is off.
+var clusterBackupListTimeout = 2 * time.Minute
+
+// waitForClusterBackupListed waits until refresh finds the request, in any
+// state, so that the Read ending Create doesn't mistake a request Palette has
+// not listed yet for one that was deleted.
+func waitForClusterBackupListed(ctx context.Context, m interface{}, d *schema.ResourceData, refresh retry.StateRefreshFunc) error {
+	const listed = "Listed"
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{""},
+		Target:  []string{listed},
+		Refresh: func() (interface{}, string, error) {
+			result, _, err := refresh()
+			if result == nil {
+				return nil, "", err
+			}
+			// A failed request is listed too, Read reports its state.
+			return result, listed, nil
+		},
+		Timeout: clusterBackupListTimeout,
+	}
+	waitSettingsFor(m, d).apply(stateConf)
+	stateConf.Delay = 0
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// formatV1Time formats t in RFC3339, or returns "" for the zero time.
+func formatV1Time(t models.V1Time) string {
+	if time.Time(t).IsZero() {
+		return ""
+	}
+	return time.Time(t).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,84 @@
+package spectrocloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The mock cluster with on-demand backups and restores, see
+// tests/mockApiServer/routes/mockClusterBackup.go.
+const clusterBackupUID = "cluster-uid-backup"
+
+func TestResourceClusterBackupCreate(t *testing.T) {
+	d := resourceClusterBackup().TestResourceData()
+	_ = d.Set("cluster_uid", clusterBackupUID)
+	_ = d.Set("name", "pre-upgrade")
+	_ = d.Set("backup_location_id", "test-bsl-location-id")
+	_ = d.Set("wait_for_completion", true)
+	_ = d.Set("namespaces", []interface{}{"app", "default"})
+	diags := resourceClusterBackupCreate(context.Background(), d, unitTestMockAPIClient)
+	require.Empty(t, diags)
+	assert.Equal(t, "backup-request-uid", d.Id())
+	assert.Equal(t, "Completed", d.Get("state"))
+	assert.Equal(t, "2026-10-01T12:00:00Z", d.Get("backup_time"))
+	assert.Equal(t, "2026-10-31T12:00:00Z", d.Get("expiry_date"))
+	assert.Equal(t, []interface{}{"app", "default"}, d.Get("backed_up_namespaces"))
+
+	d = resourceClusterBackup().TestResourceData()
+	_ = d.Set("cluster_uid", clusterBackupUID)
+	_ = d.Set("name", "broken")
+	_ = d.Set("backup_location_id", "test-bsl-location-id")
+	_ = d.Set("wait_for_completion", true)
+	diags = resourceClusterBackupCreate(context.Background(), d, unitTestMockAPIClient)
+	assertFirstDiagMessage(t, diags, "backup broken of cluster cluster-uid-backup: Failed: volume snapshot failed")
+	assert.Equal(t, "backup-request-failed-uid", d.Id(), "a failed backup stays in state")
+	assert.Equal(t, "Failed", d.Get("state"))
+}
+
+func TestResourceClusterBackupCreateWithoutWait(t *testing.T) {
+	meta := *unitTestMockAPIClient.(*providerMeta)
+	meta.wait.pollInterval = 10 * time.Millisecond
+
+	// The mock leaves the new request out of its first listing.
+	d := resourceClusterBackup().TestResourceData()
+	_ = d.Set("cluster_uid", clusterBackupUID)
+	_ = d.Set("name", "listed-late")
+	_ = d.Set("backup_location_id", "test-bsl-location-id")
+	_ = d.Set("wait_for_completion", false)
+	diags := resourceClusterBackupCreate(context.Background(), d, &meta)
+	require.Empty(t, diags)
+	assert.Equal(t, "backup-request-late-uid", d.Id(), "a backup Palette has not listed yet stays in state")
+	assert.Equal(t, "InProgress", d.Get("state"))
+}
+
+func TestResourceClusterBackupRead(t *testing.T) {
+	d := resourceClusterBackup().TestResourceData()
+	d.SetId("backup-request-uid")
+	_ = d.Set("cluster_uid", clusterBackupUID)
+	_ = d.Set("name", "pre-upgrade")
+	require.Empty(t, resourceClusterBackupRead(context.Background(), d, unitTestMockAPIClient))
+	assert.Equal(t, "test-bsl-location-id", d.Get("backup_location_id"))
+
+	d = resourceClusterBackup().TestResourceData()
+	d.SetId("deleted-backup-request-uid")
+	_ = d.Set("cluster_uid", clusterBackupUID)
+	_ = d.Set("name", "pre-upgrade")
+	require.Empty(t, resourceClusterBackupRead(context.Background(), d, unitTestMockAPIClient))
+	assert.Empty(t, d.Id(), "a backup that no longer exists is removed from state")
+}
+
+func TestResourceClusterBackupDelete(t *testing.T) {
+	d := resourceClusterBackup().TestResourceData()
+	d.SetId("backup-request-uid")
+	_ = d.Set("cluster_uid", clusterBackupUID)
+	_ = d.Set("name", "pre-upgrade")
+	_ = d.Set("skip_destroy", true)
+	assert.Empty(t, resourceClusterBackupDelete(context.Background(), d, unitTestMockAPINegativeClient), "skip_destroy never calls the API")
+
+	_ = d.Set("skip_destroy", false)
+	assert.Empty(t, resourceClusterBackupDelete(context.Background(), d, unitTestMockAPIClient))
+}
@@ -21,6 +21,7 @@ func resourceClusterBrownfield() *schema.Resource {
 		ReadContext:   resourceClusterBrownfieldRead,
 		UpdateContext: resourceClusterBrownfieldUpdate,
 		DeleteContext: resourceClusterDelete,
+		CustomizeDiff: resourceClusterCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceClusterBrownfieldImport,
 		},
@@ -98,6 +99,7 @@ func resourceClusterBrownfield() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"project", "tenant"}, false),
 				Description:  "The context for the cluster registration. Allowed values are `project` or `tenant`. Defaults to `project`. This field cannot be updated after creation." + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"proxy": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -142,7 +144,10 @@ func resourceClusterBrownfield() *schema.Resource {
 				ValidateFunc: validateTimezone,
 				Description:  "Defines the time zone used by this cluster to interpret scheduled operations. Maintenance tasks like upgrades will follow this time zone to ensure they run at the appropriate local time for the cluster. Must be in IANA timezone format (e.g., 'America/New_York', 'Asia/Kolkata', 'Europe/London').",
 			},
-			"renew_k8s_certificates_now": schemas.RenewK8sCertificatesNowSchema(),
+			"renew_k8s_certificates_now":          schemas.RenewK8sCertificatesNowSchema(),
+			"auto_renew_certificates_before_days": schemas.AutoRenewCertificatesBeforeDaysSchema(),
+			"k8s_certificates_expiry":             schemas.K8sCertificatesExpirySchema(),
+			"k8s_certificates":                    schemas.K8sCertificatesSchema(),
 			"apply_setting": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -218,6 +223,8 @@ func resourceClusterBrownfield() *schema.Resource {
 				Default:     false,
 				Description: "If `true`, the cluster will be created asynchronously. Default value is `false`.",
 			},
+			"wait_on_update": schemas.ClusterWaitOnUpdateSchema(),
+			"wait_settings":  schemas.WaitSettingsSchema(),
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -260,8 +267,13 @@ func resourceClusterBrownfieldStateUpgradeV1(ctx context.Context, rawState map[s
 
 func resourceClusterBrownfieldImportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 	var diags diag.Diagnostics
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	cloudType := d.Get("cloud_type").(string)
 	// name := d.Get("name").(string)
@@ -271,7 +283,6 @@ func resourceClusterBrownfieldImportCreate(ctx context.Context, d *schema.Resour
 
 	// Register the cluster based on cloud type
 	var clusterUID string
-	var err error
 
 	switch cloudType {
 	case "aws":
@@ -354,7 +365,7 @@ func resourceClusterBrownfieldImportCreate(ctx context.Context, d *schema.Resour
 			Detail:   "Cluster import is submitted. Please apply the manifest using `manifest_url` and run `kubectl_command` on your cluster to start the import process. Once it becomes Running and Healthy, Day-2 operations will be allowed.",
 		})
 	}
-	updateCommonFieldsForBrownfieldCluster(d, c)
+	updateCommonFieldsForBrownfieldCluster(d, c, m)
 
 	resourceClusterBrownfieldRead(ctx, d, m)
 	return diags
@@ -363,6 +374,10 @@ func resourceClusterBrownfieldImportCreate(ctx context.Context, d *schema.Resour
 // Read function - reads the current state of the cluster
 func resourceClusterBrownfieldRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 	var diags diag.Diagnostics
 	clusterUID := d.Id()
@@ -392,7 +407,7 @@ func resourceClusterBrownfieldRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// Read common fields (wrapped to skip fields not in schema)
-	readDiags, hasError := readCommonFieldsBrownfield(c, d, cluster)
+	readDiags, hasError := readCommonFieldsBrownfield(c, d, cluster, m)
 	if hasError {
 		diags = append(diags, readDiags...)
 		return diags
@@ -466,8 +481,13 @@ func resourceClusterBrownfieldRead(ctx context.Context, d *schema.ResourceData,
 // Update function - handles Day-2 operations
 func resourceClusterBrownfieldUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 	var diags diag.Diagnostics
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	// Validate Day-1 fields are immutable
 	if day1Diags := validateDay1FieldsImmutable(d); len(day1Diags) > 0 {
@@ -592,7 +612,7 @@ func resourceClusterBrownfieldUpdate(ctx context.Context, d *schema.ResourceData
 
 				// Call resourceNodeAction for node maintenance operations
 				// Use machinePoolName (from machine_pool.name) as the MachineName parameter
-				if err := resourceNodeAction(c, ctx, machinePoolForAction, getNodeMaintenanceStatusFn, cloudConfigKind, cloudConfigId, machinePoolName); err != nil {
+				if err := resourceNodeAction(c, ctx, machinePoolForAction, getNodeMaintenanceStatusFn, cloudConfigKind, cloudConfigId, machinePoolName, waitSettingsFor(m, d)); err != nil {
 					return diag.FromErr(fmt.Errorf("failed to perform node action on machine pool %s: %w", machinePoolName, err))
 				}
 			}
@@ -600,12 +620,16 @@ func resourceClusterBrownfieldUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	// Update common fields for Day-2 operations
-	updateDiags, done := updateCommonFields(d, c)
+	updateDiags, done := updateCommonFields(ctx, d, c, m)
 	if done {
 		return updateDiags
 	}
 	diags = append(diags, updateDiags...)
 
+	if waitDiags := waitForClusterUpdate(ctx, d, c, m); waitDiags.HasError() {
+		return waitDiags
+	}
+
 	// Refresh state
 	readDiags := resourceClusterBrownfieldRead(ctx, d, m)
 	diags = append(diags, readDiags...)
@@ -737,7 +761,7 @@ func toImportClusterConfig(d *schema.ResourceData) *models.V1ImportClusterConfig
 }
 
 // readCommonFieldsBrownfield wraps readCommonFields to skip fields that don't exist in brownfield schema
-func readCommonFieldsBrownfield(c *client.V1Client, d *schema.ResourceData, cluster *models.V1SpectroCluster) (diag.Diagnostics, bool) {
+func readCommonFieldsBrownfield(c *client.V1Client, d *schema.ResourceData, cluster *models.V1SpectroCluster, m interface{}) (diag.Diagnostics, bool) {
 	// Set tags (always present)
 	if err := d.Set("tags", flattenTags(cluster.Metadata.Labels)); err != nil {
 		return diag.FromErr(err), true
@@ -828,7 +852,11 @@ func readCommonFieldsBrownfield(c *client.V1Client, d *schema.ResourceData, clus
 		}
 	}
 
-	if diags := syncClusterProfilesFromAPIWhenAddonDeploymentDisabled(c, d, cluster); diags.HasError() {
+	if diags := syncClusterProfilesFromAPIWhenAddonDeploymentDisabled(c, d, cluster, m); diags.HasError() {
+		return diags, true
+	}
+
+	if diags := readK8sCertificates(c, d); diags.HasError() {
 		return diags, true
 	}
 
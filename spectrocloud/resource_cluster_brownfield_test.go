@@ -448,7 +448,7 @@ func TestReadCommonFieldsBrownfield(t *testing.T) {
 			c := tt.setupClient()
 			d := tt.setupData()
 
-			diags, hasError := readCommonFieldsBrownfield(c, d, tt.cluster)
+			diags, hasError := readCommonFieldsBrownfield(c, d, tt.cluster, nil)
 
 			if tt.expectError {
 				assert.True(t, hasError || diags.HasError(), "Expected error but got none")
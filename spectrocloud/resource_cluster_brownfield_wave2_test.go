@@ -261,7 +261,7 @@ func TestReadCommonFieldsBrownfield_OptionalFieldsAndErrors(t *testing.T) {
 			d.SetId(tt.id)
 			tt.setup(d)
 
-			diags, hasError := readCommonFieldsBrownfield(c, d, brownfieldClusterFixtureForRead())
+			diags, hasError := readCommonFieldsBrownfield(c, d, brownfieldClusterFixtureForRead(), nil)
 
 			if tt.expectError {
 				assert.True(t, hasError || diags.HasError(), "expected an error")
@@ -312,7 +312,7 @@ func TestReadCommonFieldsBrownfield_HostConfigBranches(t *testing.T) {
 			cluster := brownfieldClusterFixtureForRead()
 			cluster.Spec.ClusterConfig.HostClusterConfig = tt.hostConfig
 
-			diags, hasError := readCommonFieldsBrownfield(c, d, cluster)
+			diags, hasError := readCommonFieldsBrownfield(c, d, cluster, nil)
 			assert.False(t, hasError, "unexpected error: %+v", diags)
 		})
 	}
@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
 	"github.com/spectrocloud/palette-sdk-go/client/herr"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func resourceClusterConfigPolicy() *schema.Resource {
@@ -45,6 +47,7 @@ func resourceClusterConfigPolicy() *schema.Resource {
 				Description: "The context of the cluster config policy. Allowed values are `project` or `tenant`. " +
 					"Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -90,6 +93,10 @@ func resourceClusterConfigPolicy() *schema.Resource {
 }
 
 func resourceClusterConfigPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
 
 	policy := &models.V1SpcPolicyEntity{
@@ -112,6 +119,10 @@ func resourceClusterConfigPolicyCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceClusterConfigPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
 	var diags diag.Diagnostics
 	uid := d.Id()
@@ -142,6 +153,10 @@ func resourceClusterConfigPolicyRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceClusterConfigPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
 
 	policy := &models.V1SpcPolicyEntity{
@@ -154,7 +169,7 @@ func resourceClusterConfigPolicyUpdate(ctx context.Context, d *schema.ResourceDa
 		},
 	}
 
-	err := c.UpdateClusterConfigPolicy(d.Id(), policy)
+	err = c.UpdateClusterConfigPolicy(d.Id(), policy)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -163,9 +178,13 @@ func resourceClusterConfigPolicyUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceClusterConfigPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
 
-	err := c.DeleteClusterConfigPolicy(d.Id())
+	err = c.DeleteClusterConfigPolicy(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
 	"github.com/spectrocloud/palette-sdk-go/client/herr"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func resourceClusterConfigTemplate() *schema.Resource {
@@ -45,6 +47,7 @@ func resourceClusterConfigTemplate() *schema.Resource {
 				Description: "The context of the cluster config template. Allowed values are `project` or `tenant`. " +
 					"Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -167,6 +170,10 @@ func resourceClusterConfigTemplate() *schema.Resource {
 }
 
 func resourceClusterConfigTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
 
 	metadata := &models.V1ObjectMetaInputEntity{
@@ -200,6 +207,10 @@ func resourceClusterConfigTemplateCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceClusterConfigTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
 	var diags diag.Diagnostics
 	uid := d.Id()
@@ -256,6 +267,10 @@ func resourceClusterConfigTemplateRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceClusterConfigTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
 
 	// Handle metadata updates (name, tags, description)
@@ -336,9 +351,13 @@ func resourceClusterConfigTemplateUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceClusterConfigTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
 
-	err := c.DeleteClusterConfigTemplate(d.Id())
+	err = c.DeleteClusterConfigTemplate(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -50,6 +50,7 @@ func resourceClusterCustomCloud() *schema.Resource {
 				Version: 3,
 			},
 		},
+		CustomizeDiff: resourceClusterCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -65,6 +66,7 @@ func resourceClusterCustomCloud() *schema.Resource {
 				Description: "The context of the EKS cluster. Allowed values are `project` or `tenant`. " +
 					"Default is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"cloud": {
 				Type:        schema.TypeString,
 				ForceNew:    true,
@@ -117,7 +119,10 @@ func resourceClusterCustomCloud() *schema.Resource {
 				ValidateFunc: validateTimezone,
 				Description:  "Defines the time zone used by this cluster to interpret scheduled operations. Maintenance tasks like upgrades will follow this time zone to ensure they run at the appropriate local time for the cluster. Must be in IANA timezone format (e.g., 'America/New_York', 'Asia/Kolkata', 'Europe/London').",
 			},
-			"renew_k8s_certificates_now": schemas.RenewK8sCertificatesNowSchema(),
+			"renew_k8s_certificates_now":          schemas.RenewK8sCertificatesNowSchema(),
+			"auto_renew_certificates_before_days": schemas.AutoRenewCertificatesBeforeDaysSchema(),
+			"k8s_certificates_expiry":             schemas.K8sCertificatesExpirySchema(),
+			"k8s_certificates":                    schemas.K8sCertificatesSchema(),
 			"update_worker_pools_in_parallel": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -271,6 +276,9 @@ func resourceClusterCustomCloud() *schema.Resource {
 				Default:     false,
 				Description: "If `true`, the cluster will be created asynchronously. Default value is `false`.",
 			},
+			"wait_for":       schemas.ClusterWaitForSchema(),
+			"wait_on_update": schemas.ClusterWaitOnUpdateSchema(),
+			"wait_settings":  schemas.WaitSettingsSchema(),
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -314,9 +322,14 @@ func resourceClusterCustomCloudStateUpgradeV3(ctx context.Context, rawState map[
 
 func resourceClusterCustomCloudCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	cluster, err := toCustomCloudCluster(c, d)
 	if err != nil {
@@ -334,7 +347,7 @@ func resourceClusterCustomCloudCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true)
+	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true, m)
 	if len(diagnostics) > 0 {
 		diags = append(diags, diagnostics...)
 	}
@@ -365,6 +378,10 @@ func resourceClusterCustomCloudRead(ctx context.Context, d *schema.ResourceData,
 	log.Printf("[ERROR] !!!!!!! DEBUG SESSION TEST - CUSTOM CLOUD READ CALLED !!!!!!!")
 	log.Printf("[ERROR] ======= CUSTOM CLOUD READ START =======")
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -381,7 +398,7 @@ func resourceClusterCustomCloudRead(ctx context.Context, d *schema.ResourceData,
 	}
 	log.Printf("[ERROR] resourceClusterRead succeeded")
 
-	diagnostics, hasError := readCommonFields(c, d, cluster)
+	diagnostics, hasError := readCommonFields(c, d, cluster, m)
 	if hasError {
 		log.Printf("[ERROR] readCommonFields failed")
 		return diagnostics
@@ -405,10 +422,15 @@ func resourceClusterCustomCloudRead(ctx context.Context, d *schema.ResourceData,
 
 func resourceClusterCustomCloudUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	// Validate that cluster_type is not being modified (it's a create-only field)
 	if err := ValidateClusterTypeUpdate(d); err != nil {
@@ -419,7 +441,7 @@ func resourceClusterCustomCloudUpdate(ctx context.Context, d *schema.ResourceDat
 	//clusterContext := d.Get("context").(string)
 	cloudType := d.Get("cloud").(string)
 
-	_, err := c.GetCloudConfigCustomCloud(cloudConfigId, cloudType)
+	_, err = c.GetCloudConfigCustomCloud(cloudConfigId, cloudType)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -508,11 +530,15 @@ func resourceClusterCustomCloudUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
-	diagnostics, done := updateCommonFields(d, c)
+	diagnostics, done := updateCommonFields(ctx, d, c, m)
 	if done {
 		return diagnostics
 	}
 
+	if waitDiags := waitForClusterUpdate(ctx, d, c, m); waitDiags.HasError() {
+		return waitDiags
+	}
+
 	resourceClusterCustomCloudRead(ctx, d, m)
 
 	return diags
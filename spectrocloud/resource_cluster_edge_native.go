@@ -48,6 +48,7 @@ func resourceClusterEdgeNative() *schema.Resource {
 				Version: 3,
 			},
 		},
+		CustomizeDiff: resourceClusterCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -63,6 +64,7 @@ func resourceClusterEdgeNative() *schema.Resource {
 				Description: "The context of the Edge cluster. Allowed values are `project` or `tenant`. " +
 					"Default is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -138,7 +140,10 @@ func resourceClusterEdgeNative() *schema.Resource {
 				ValidateFunc: validateTimezone,
 				Description:  "Defines the time zone used by this cluster to interpret scheduled operations. Maintenance tasks like upgrades will follow this time zone to ensure they run at the appropriate local time for the cluster. Must be in IANA timezone format (e.g., 'America/New_York', 'Asia/Kolkata', 'Europe/London').",
 			},
-			"renew_k8s_certificates_now": schemas.RenewK8sCertificatesNowSchema(),
+			"renew_k8s_certificates_now":          schemas.RenewK8sCertificatesNowSchema(),
+			"auto_renew_certificates_before_days": schemas.AutoRenewCertificatesBeforeDaysSchema(),
+			"k8s_certificates_expiry":             schemas.K8sCertificatesExpirySchema(),
+			"k8s_certificates":                    schemas.K8sCertificatesSchema(),
 			"update_worker_pools_in_parallel": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -353,6 +358,9 @@ func resourceClusterEdgeNative() *schema.Resource {
 				Default:     false,
 				Description: "If `true`, the cluster will be created asynchronously. Default value is `false`.",
 			},
+			"wait_for":       schemas.ClusterWaitForSchema(),
+			"wait_on_update": schemas.ClusterWaitOnUpdateSchema(),
+			"wait_settings":  schemas.WaitSettingsSchema(),
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -395,11 +403,16 @@ func resourceClusterEdgeNativeStateUpgradeV3(ctx context.Context, rawState map[s
 
 func resourceClusterEdgeNativeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 	appendOverrideHealthCheckConfigurationCreateWarnings(d, &diags)
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	// Validate override_Scaling configuration
 	if err := validateOverrideScaling(d, "machine_pool"); err != nil {
@@ -416,7 +429,7 @@ func resourceClusterEdgeNativeCreate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true)
+	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true, m)
 	if len(diagnostics) > 0 {
 		diags = append(diags, diagnostics...)
 	}
@@ -429,8 +442,12 @@ func resourceClusterEdgeNativeCreate(ctx context.Context, d *schema.ResourceData
 }
 
 //goland:noinspection GoUnhandledErrorResult
-func resourceClusterEdgeNativeRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceClusterEdgeNativeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -451,7 +468,7 @@ func resourceClusterEdgeNativeRead(_ context.Context, d *schema.ResourceData, m
 	}
 
 	// Update the kubeconfig
-	diagnostics, errorSet := readCommonFields(c, d, cluster)
+	diagnostics, errorSet := readCommonFields(c, d, cluster, m)
 	if errorSet {
 		return diagnostics
 	}
@@ -611,13 +628,18 @@ func flattenMachinePoolConfigsEdgeNative(machinePools []*models.V1EdgeNativeMach
 
 func resourceClusterEdgeNativeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 	warningMessageForNodeDeletion := false
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 	appendOverrideHealthCheckConfigurationUpdateWarnings(d, &diags)
-	err := validateSystemRepaveApproval(d, c)
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
+	err = validateSystemRepaveApproval(d, c)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -735,7 +757,7 @@ func resourceClusterEdgeNativeUpdate(ctx context.Context, d *schema.ResourceData
 					if err != nil {
 						return diag.FromErr(err)
 					}
-					err = resourceNodeAction(c, ctx, nsMap[name], c.GetNodeMaintenanceStatusEdgeNative, "edge-native", cloudConfigId, name)
+					err = resourceNodeAction(c, ctx, nsMap[name], c.GetNodeMaintenanceStatusEdgeNative, "edge-native", cloudConfigId, name, waitSettingsFor(m, d))
 					if err != nil {
 						return diag.FromErr(err)
 					}
@@ -769,7 +791,7 @@ func resourceClusterEdgeNativeUpdate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	diagnostics, errorSet := updateCommonFields(d, c)
+	diagnostics, errorSet := updateCommonFields(ctx, d, c, m)
 	if errorSet {
 		return diagnostics
 	}
@@ -780,6 +802,11 @@ func resourceClusterEdgeNativeUpdate(ctx context.Context, d *schema.ResourceData
 			Detail:   "The Machine pool node deletion has been triggered. This is an asynchronous operation and may take some time to complete.",
 		})
 	}
+
+	if waitDiags := waitForClusterUpdate(ctx, d, c, m); waitDiags.HasError() {
+		return waitDiags
+	}
+
 	readDiags := resourceClusterEdgeNativeRead(ctx, d, m)
 	if len(readDiags) > 0 {
 		diags = append(diags, readDiags...)
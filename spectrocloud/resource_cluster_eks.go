@@ -64,6 +64,7 @@ func resourceClusterEks() *schema.Resource {
 				Description: "The context of the EKS cluster. Allowed values are `project` or `tenant`. " +
 					"Default is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -155,7 +156,10 @@ func resourceClusterEks() *schema.Resource {
 				ValidateFunc: validateTimezone,
 				Description:  "Defines the time zone used by this cluster to interpret scheduled operations. Maintenance tasks like upgrades will follow this time zone to ensure they run at the appropriate local time for the cluster. Must be in IANA timezone format (e.g., 'America/New_York', 'Asia/Kolkata', 'Europe/London').",
 			},
-			"renew_k8s_certificates_now": schemas.RenewK8sCertificatesNowSchema(),
+			"renew_k8s_certificates_now":          schemas.RenewK8sCertificatesNowSchema(),
+			"auto_renew_certificates_before_days": schemas.AutoRenewCertificatesBeforeDaysSchema(),
+			"k8s_certificates_expiry":             schemas.K8sCertificatesExpirySchema(),
+			"k8s_certificates":                    schemas.K8sCertificatesSchema(),
 			"update_worker_pools_in_parallel": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -434,6 +438,9 @@ func resourceClusterEks() *schema.Resource {
 				Default:     false,
 				Description: "If `true`, the cluster will be created asynchronously. Default value is `false`.",
 			},
+			"wait_for":       schemas.ClusterWaitForSchema(),
+			"wait_on_update": schemas.ClusterWaitOnUpdateSchema(),
+			"wait_settings":  schemas.WaitSettingsSchema(),
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -451,8 +458,11 @@ func resourceClusterEks() *schema.Resource {
 	}
 }
 
-func resourceClusterEksCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
-	return validateEksMachinePoolsAutoscalingCount(diff.Get("machine_pool"))
+func resourceClusterEksCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if err := validateEksMachinePoolsAutoscalingCount(diff.Get("machine_pool")); err != nil {
+		return err
+	}
+	return resourceClusterCustomizeDiff(ctx, diff, m)
 }
 
 // validateEksMachinePoolsAutoscalingCount enforces that when autoscaling is active (min and max both > 0),
@@ -500,10 +510,15 @@ func validateEksMachinePoolsAutoscalingCount(raw interface{}) error {
 
 func resourceClusterEksCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	// Validate override_Scaling configuration
 	if err := validateOverrideScaling(d, "machine_pool"); err != nil {
@@ -520,7 +535,7 @@ func resourceClusterEksCreate(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true)
+	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true, m)
 	if len(diagnostics) > 0 {
 		diags = append(diags, diagnostics...)
 	}
@@ -533,8 +548,12 @@ func resourceClusterEksCreate(ctx context.Context, d *schema.ResourceData, m int
 	return diags
 }
 
-func resourceClusterEksRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceClusterEksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -586,7 +605,7 @@ func resourceClusterEksRead(_ context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
-	diagnostics, done := readCommonFields(c, d, cluster)
+	diagnostics, done := readCommonFields(c, d, cluster, m)
 
 	// handling flatten tags_map for aws  cluster
 	if _, ok := d.GetOk("tags_map"); ok {
@@ -813,12 +832,17 @@ func flattenFargateProfilesEks(fargateProfiles []*models.V1FargateProfile) []int
 
 func resourceClusterEksUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
-	err := validateSystemRepaveApproval(d, c)
+	err = validateSystemRepaveApproval(d, c)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -915,7 +939,7 @@ func resourceClusterEksUpdate(ctx context.Context, d *schema.ResourceData, m int
 							return diag.FromErr(err)
 						}
 						// Node Maintenance Actions
-						err := resourceNodeAction(c, ctx, machinePoolResource, c.GetNodeMaintenanceStatusEks, CloudConfig.Kind, cloudConfigId, name)
+						err := resourceNodeAction(c, ctx, machinePoolResource, c.GetNodeMaintenanceStatusEks, CloudConfig.Kind, cloudConfigId, name, waitSettingsFor(m, d))
 						if err != nil {
 							return diag.FromErr(err)
 						}
@@ -941,11 +965,15 @@ func resourceClusterEksUpdate(ctx context.Context, d *schema.ResourceData, m int
 		}
 	}
 
-	diagnostics, done := updateCommonFields(d, c)
+	diagnostics, done := updateCommonFields(ctx, d, c, m)
 	if done {
 		return diagnostics
 	}
 
+	if waitDiags := waitForClusterUpdate(ctx, d, c, m); waitDiags.HasError() {
+		return waitDiags
+	}
+
 	resourceClusterEksRead(ctx, d, m)
 
 	return diags
@@ -40,6 +40,7 @@ func resourceClusterGroup() *schema.Resource {
 				Version: 2,
 			},
 		},
+		CustomizeDiff: validateClusterProfileVariables,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -54,6 +55,7 @@ func resourceClusterGroup() *schema.Resource {
 				Description: "The context of the Cluster group. Allowed values are `project` or `tenant`. " +
 					"Defaults to `tenant`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -167,10 +169,15 @@ func resourceClusterGroupStateUpgradeV2(ctx context.Context, rawState map[string
 
 func resourceClusterGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 	cluster := toClusterGroup(c, d)
 
 	uid, err := c.CreateClusterGroup(cluster)
@@ -186,8 +193,12 @@ func resourceClusterGroupCreate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 //goland:noinspection GoUnhandledErrorResult
-func resourceClusterGroupRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceClusterGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -273,12 +284,17 @@ func flattenClusterGroup(clusterGroup *models.V1ClusterGroup, d *schema.Resource
 
 func resourceClusterGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 	// if there are changes in the name of  cluster group, update it using UpdateClusterGroupMeta()
 	clusterGroup := toClusterGroup(c, d)
-	err := c.UpdateClusterGroupMeta(clusterGroup)
+	err = c.UpdateClusterGroupMeta(clusterGroup)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -429,10 +445,14 @@ func toClusterGroupLimitConfig(resources map[string]interface{}) *models.V1Clust
 
 func resourceClusterGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
-	err := c.DeleteClusterGroup(d.Id())
+	err = c.DeleteClusterGroup(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
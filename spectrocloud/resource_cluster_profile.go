@@ -91,6 +91,7 @@ func resourceClusterProfile() *schema.Resource {
 				Description: "The context of the cluster profile. Allowed values are `project` or `tenant`. " +
 					"Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -179,12 +180,19 @@ func resourceClusterProfile() *schema.Resource {
 //     server-side). That would be the same class of bug as the
 //     `clone-on-version-change` stale-output issue this whole PR was written to
 //     fix -- a documented invariant that the code doesn't enforce.
-func resourceClusterProfileCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+//
+// Independently of the flag, the values of added or changed packs are checked
+// against the pack's registry entry first (see validateClusterProfilePackValues).
+func resourceClusterProfileCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if err := validateClusterProfilePackValues(ctx, d, m); err != nil {
+		return err
+	}
+
 	if d.Id() == "" {
 		// New resource -- no in-place update to convert
 		return nil
 	}
-	if !isFeaturePreviewEnabled("immutable-clusterprofiles") {
+	if !isFeaturePreviewEnabled(m, "immutable-clusterprofiles") {
 		return nil
 	}
 
@@ -322,13 +330,14 @@ func canonicalizePackElement(m map[string]interface{}) map[string]interface{} {
 		packType = "spectro"
 	}
 	return map[string]interface{}{
-		"name":          canonicalString(m["name"]),
-		"tag":           canonicalString(m["tag"]),
-		"type":          packType,
-		"values":        strings.TrimSpace(canonicalString(m["values"])),
-		"registry_uid":  canonicalString(m["registry_uid"]),
-		"registry_name": canonicalString(m["registry_name"]),
-		"manifest":      canonicalizeManifestList(m["manifest"]),
+		"name":             canonicalString(m["name"]),
+		"tag":              canonicalString(m["tag"]),
+		"type":             packType,
+		"values":           strings.TrimSpace(canonicalString(m["values"])),
+		"values_overrides": schemas.PackValuesOverrides(m),
+		"registry_uid":     canonicalString(m["registry_uid"]),
+		"registry_name":    canonicalString(m["registry_name"]),
+		"manifest":         canonicalizeManifestList(m["manifest"]),
 	}
 }
 
@@ -491,10 +500,14 @@ func findAnyExistingProfileVersionUID(c *client.V1Client, name string) (string,
 
 func resourceClusterProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ProfileContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, ProfileContext)
 
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
+	// Unknown pack values keys don't fail the apply, they are only reported.
+	diags := clusterProfilePackValuesWarnings(ctx, d, m)
 
 	// immutable-clusterprofiles: this is the Create half of the standard Terraform
 	// Plugin SDK v2 replacement lifecycle for immutable-versioned resources. When
@@ -513,7 +526,7 @@ func resourceClusterProfileCreate(ctx context.Context, d *schema.ResourceData, m
 	// mutates again, which is the SDK v2 contract -- and it's what makes outputs
 	// against `.id` correct in the post-apply state without needing
 	// `terraform apply -refresh-only`.
-	if isFeaturePreviewEnabled("immutable-clusterprofiles") {
+	if isFeaturePreviewEnabled(m, "immutable-clusterprofiles") {
 		name := d.Get("name").(string)
 		version := d.Get("version").(string)
 
@@ -603,7 +616,7 @@ func resourceClusterProfileCreate(ctx context.Context, d *schema.ResourceData, m
 	uid, err := c.CreateClusterProfile(clusterProfile)
 	adopted := false
 	if err != nil {
-		if !isFeaturePreviewEnabled("immutable-clusterprofiles") {
+		if !isFeaturePreviewEnabled(m, "immutable-clusterprofiles") {
 			return diag.FromErr(err)
 		}
 		// SDK v2 adopt-on-create pattern: if the profile already exists in Palette
@@ -633,8 +646,12 @@ func resourceClusterProfileCreate(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
-func resourceClusterProfileRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceClusterProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ProfileContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, ProfileContext)
 
 	var diags diag.Diagnostics
@@ -695,6 +712,11 @@ func resourceClusterProfileRead(_ context.Context, d *schema.ResourceData, m int
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	configPacks, _ := d.Get("pack").([]interface{})
+	for _, pack := range packs {
+		pack := pack.(map[string]interface{})
+		alignPackValuesWithOverrides(pack, findConfigPackByName(configPacks, pack["name"].(string)), false)
+	}
 	if err := d.Set("pack", packs); err != nil {
 		return diag.FromErr(err)
 	}
@@ -720,10 +742,14 @@ func flattenClusterProfileCommon(d *schema.ResourceData, cp *models.V1ClusterPro
 
 func resourceClusterProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ProfileContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, ProfileContext)
 
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
+	// Unknown pack values keys don't fail the apply, they are only reported.
+	diags := clusterProfilePackValuesWarnings(ctx, d, m)
 
 	if d.HasChanges("profile_variables") {
 		pvs, err := toClusterProfileVariables(d)
@@ -788,8 +814,12 @@ func resourceClusterProfileUpdate(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
-func resourceClusterProfileDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceClusterProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ProfileContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, ProfileContext)
 
 	var diags diag.Diagnostics
@@ -972,14 +1002,18 @@ func toClusterProfilePackCreateWithResolution(pSrc interface{}, c *client.V1Clie
 		}
 	}
 
+	values, err := packValuesWithOverrides(p, packDefaultValues(c, pUID, pType))
+	if err != nil {
+		return nil, err
+	}
+
 	pack := &models.V1PackManifestEntity{
 		Name:        types.Ptr(pName),
 		Tag:         p["tag"].(string),
 		RegistryUID: pRegistryUID,
 		UID:         pUID,
 		Type:        &pType,
-		// UI strips a single newline, so we should do the same
-		Values: strings.TrimSpace(p["values"].(string)),
+		Values:      values,
 	}
 
 	manifests := make([]*models.V1ManifestInputEntity, 0)
@@ -1081,6 +1115,11 @@ func toClusterProfilePackUpdateWithResolution(pSrc interface{}, packs []*models.
 		}
 	}
 
+	values, err := packValuesWithOverrides(p, packDefaultValues(c, pUID, pType))
+	if err != nil {
+		return nil, err
+	}
+
 	pack := &models.V1PackManifestUpdateEntity{
 		//Layer:  p["layer"].(string),
 		Name:        types.Ptr(pName),
@@ -1088,8 +1127,7 @@ func toClusterProfilePackUpdateWithResolution(pSrc interface{}, packs []*models.
 		RegistryUID: pRegistryUID,
 		UID:         pUID,
 		Type:        &pType,
-		// UI strips a single newline, so we should do the same
-		Values: strings.TrimSpace(p["values"].(string)),
+		Values:      values,
 	}
 
 	manifests := make([]*models.V1ManifestRefUpdateEntity, 0)
@@ -11,6 +11,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func resourceClusterProfileImportFeature() *schema.Resource {
@@ -35,6 +37,7 @@ func resourceClusterProfileImportFeature() *schema.Resource {
 				Description: "Allowed values are `project`, `tenant` or `system`. " +
 					"Defaults to `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 		},
 	}
 }
@@ -42,6 +45,10 @@ func resourceClusterProfileImportFeature() *schema.Resource {
 // implement the resource functions
 func resourceClusterProfileImportFeatureCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ProfileContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, ProfileContext)
 
 	importFile, err := toClusterProfileImportCreate(d)
@@ -118,6 +125,10 @@ func resourceClusterProfileImportFeatureRead(ctx context.Context, d *schema.Reso
 
 func resourceClusterProfileImportFeatureUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	importFile, err := toClusterProfileImportCreate(d)
@@ -144,6 +155,10 @@ func resourceClusterProfileImportFeatureUpdate(ctx context.Context, d *schema.Re
 
 func resourceClusterProfileImportFeatureDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Call the API endpoint to delete the cluster profile import resource
@@ -825,10 +825,6 @@ func prepareClusterProfileWithVersionChange(oldVersion, newVersion, profileName
 // Terraform plans a replacement and the new version is produced by the Create
 // function (see TestResourceClusterProfileCreate_ImmutableClusterprofiles_*).
 func TestResourceClusterProfileUpdateVersionNoFlag(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{}
-
 	d := prepareClusterProfileWithVersionChange("1.0.0", "2.0.0", "nonexistent-profile", nil)
 	var ctx context.Context
 
@@ -845,9 +841,7 @@ func TestResourceClusterProfileUpdateVersionNoFlag(t *testing.T) {
 // via the UI) AND the immutable-clusterprofiles flag is enabled, the function
 // adopts the existing UID into Terraform state instead of returning an error.
 func TestResourceClusterProfileCreateAdoptExisting(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{"immutable-clusterprofiles": true}
+	m := withTestFeaturePreview(unitTestMockAPIClient, "immutable-clusterprofiles")
 
 	d := prepareBaseClusterProfileTestData()
 	// Use name+version matching mock metadata → adopt path
@@ -855,7 +849,7 @@ func TestResourceClusterProfileCreateAdoptExisting(t *testing.T) {
 	_ = d.Set("version", "1.0.0")
 	_ = d.Set("type", "add-on")
 	var ctx context.Context
-	diags := resourceClusterProfileCreate(ctx, d, unitTestMockAPIClient)
+	diags := resourceClusterProfileCreate(ctx, d, m)
 	assert.Empty(t, diags)
 	// Should have adopted the existing UID from the mock metadata.
 	assert.Equal(t, "cluster-profile-import-1", d.Id())
@@ -867,10 +861,6 @@ func TestResourceClusterProfileCreateAdoptExisting(t *testing.T) {
 // legacy "create is not idempotent" behavior for users who haven't opted into
 // the new flag.
 func TestResourceClusterProfileCreateNoAdoptWithoutFlag(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{}
-
 	d := prepareBaseClusterProfileTestData()
 	_ = d.Set("name", "test-cluster-profile-1")
 	_ = d.Set("version", "1.0.0")
@@ -921,7 +911,7 @@ func TestResourceClusterProfileSchema_CustomizeDiffRegistered(t *testing.T) {
 // resource. skipDestroyInConfig controls whether the user's HCL sets
 // skip_destroy = true, which is what the CustomizeDiff plan-time validation
 // checks for.
-func customizeDiffFixture(oldVersion, newVersion string, skipDestroyInConfig bool) (*terraform.InstanceDiff, error) {
+func customizeDiffFixture(m interface{}, oldVersion, newVersion string, skipDestroyInConfig bool) (*terraform.InstanceDiff, error) {
 	r := resourceClusterProfile()
 	state := &terraform.InstanceState{
 		ID: "cluster-profile-1",
@@ -946,7 +936,7 @@ func customizeDiffFixture(oldVersion, newVersion string, skipDestroyInConfig boo
 		"type":         "add-on",
 		"skip_destroy": skipDestroyInConfig,
 	}
-	return r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(cfg), unitTestMockAPIClient)
+	return r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(cfg), m)
 }
 
 // TestResourceClusterProfileCustomizeDiff_VersionBump_MissingSkipDestroy
@@ -956,11 +946,9 @@ func customizeDiffFixture(oldVersion, newVersion string, skipDestroyInConfig boo
 // against the common mistake of enabling the flag but forgetting the companion
 // SDK v2 pattern attributes.
 func TestResourceClusterProfileCustomizeDiff_VersionBump_MissingSkipDestroy(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{"immutable-clusterprofiles": true}
+	m := withTestFeaturePreview(unitTestMockAPIClient, "immutable-clusterprofiles")
 
-	_, err := customizeDiffFixture("1.0.0", "1.1.0", false)
+	_, err := customizeDiffFixture(m, "1.0.0", "1.1.0", false)
 	assert.Error(t, err, "plan must error when version changes under the flag without skip_destroy = true")
 	assert.Contains(t, err.Error(), "skip_destroy = true")
 	assert.Contains(t, err.Error(), "create_before_destroy = true")
@@ -972,11 +960,9 @@ func TestResourceClusterProfileCustomizeDiff_VersionBump_MissingSkipDestroy(t *t
 // is marked as a replacement (ForceNew). This is the intended happy path under
 // the immutable-clusterprofiles flag.
 func TestResourceClusterProfileCustomizeDiff_VersionBump_WithSkipDestroy(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{"immutable-clusterprofiles": true}
+	m := withTestFeaturePreview(unitTestMockAPIClient, "immutable-clusterprofiles")
 
-	diff, err := customizeDiffFixture("1.0.0", "1.1.0", true)
+	diff, err := customizeDiffFixture(m, "1.0.0", "1.1.0", true)
 	assert.NoError(t, err)
 	assert.NotNil(t, diff)
 	versionAttr, ok := diff.Attributes["version"]
@@ -989,11 +975,7 @@ func TestResourceClusterProfileCustomizeDiff_VersionBump_WithSkipDestroy(t *test
 // bypassed entirely and version changes behave like any other in-place update
 // -- no ForceNew, no skip_destroy requirement. This is the backward-compat path.
 func TestResourceClusterProfileCustomizeDiff_VersionBump_FlagOff(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{}
-
-	diff, err := customizeDiffFixture("1.0.0", "1.1.0", false)
+	diff, err := customizeDiffFixture(unitTestMockAPIClient, "1.0.0", "1.1.0", false)
 	assert.NoError(t, err, "without the flag, version changes must not require skip_destroy")
 	assert.NotNil(t, diff)
 	if versionAttr, ok := diff.Attributes["version"]; ok {
@@ -1004,7 +986,7 @@ func TestResourceClusterProfileCustomizeDiff_VersionBump_FlagOff(t *testing.T) {
 // customizeDiffContentChangeFixture drives Resource.Diff with a content change
 // (description field) on an existing resource while keeping the version field
 // the same. Used by the content-change-without-version-bump CustomizeDiff tests.
-func customizeDiffContentChangeFixture(oldDescription, newDescription string) (*terraform.InstanceDiff, error) {
+func customizeDiffContentChangeFixture(m interface{}, oldDescription, newDescription string) (*terraform.InstanceDiff, error) {
 	r := resourceClusterProfile()
 	state := &terraform.InstanceState{
 		ID: "cluster-profile-1",
@@ -1030,7 +1012,7 @@ func customizeDiffContentChangeFixture(oldDescription, newDescription string) (*
 		"type":         "add-on",
 		"skip_destroy": true,
 	}
-	return r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(cfg), unitTestMockAPIClient)
+	return r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(cfg), m)
 }
 
 // TestResourceClusterProfileCustomizeDiff_ContentChange_WithoutVersionBump_UnderFlag
@@ -1045,11 +1027,9 @@ func customizeDiffContentChangeFixture(oldDescription, newDescription string) (*
 // defeating the whole point of the feature flag. Caught empirically during
 // end-to-end demo walkthrough on 2026-04-08.
 func TestResourceClusterProfileCustomizeDiff_ContentChange_WithoutVersionBump_UnderFlag(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{"immutable-clusterprofiles": true}
+	m := withTestFeaturePreview(unitTestMockAPIClient, "immutable-clusterprofiles")
 
-	_, err := customizeDiffContentChangeFixture("original description", "mutated description")
+	_, err := customizeDiffContentChangeFixture(m, "original description", "mutated description")
 	assert.Error(t, err, "plan must error when content fields change under the flag without a version bump")
 	assert.Contains(t, err.Error(), "immutable-clusterprofiles")
 	assert.Contains(t, err.Error(), "description")
@@ -1063,11 +1043,7 @@ func TestResourceClusterProfileCustomizeDiff_ContentChange_WithoutVersionBump_Un
 // behavior -- users who haven't opted into immutability can still patch their
 // cluster profiles in place (the destructive PUT path the provider always had).
 func TestResourceClusterProfileCustomizeDiff_ContentChange_WithoutVersionBump_FlagOff(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{}
-
-	diff, err := customizeDiffContentChangeFixture("original description", "mutated description")
+	diff, err := customizeDiffContentChangeFixture(unitTestMockAPIClient, "original description", "mutated description")
 	assert.NoError(t, err, "without the flag, content changes must not be blocked at plan time")
 	assert.NotNil(t, diff)
 	// Description should be in the diff as a normal update, not a replacement.
@@ -1081,12 +1057,10 @@ func TestResourceClusterProfileCustomizeDiff_ContentChange_WithoutVersionBump_Fl
 // config), CustomizeDiff returns nil without error. Guards against accidentally
 // erroring on no-op applies.
 func TestResourceClusterProfileCustomizeDiff_NoChanges_UnderFlag(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{"immutable-clusterprofiles": true}
+	m := withTestFeaturePreview(unitTestMockAPIClient, "immutable-clusterprofiles")
 
 	// Same description on both sides -- no change.
-	_, err := customizeDiffContentChangeFixture("same description", "same description")
+	_, err := customizeDiffContentChangeFixture(m, "same description", "same description")
 	assert.NoError(t, err, "no-op applies under the flag must not error")
 }
 
@@ -1101,7 +1075,7 @@ func TestResourceClusterProfileCustomizeDiff_NoChanges_UnderFlag(t *testing.T) {
 // different `values` content). packCountInConfig controls how many pack
 // elements the config declares -- setting it to 0 or 2 while state has 1
 // simulates a real pack add/remove.
-func customizeDiffPackFixture(stateValues, newValues string, packCountInConfig int) (*terraform.InstanceDiff, error) {
+func customizeDiffPackFixture(m interface{}, stateValues, newValues string, packCountInConfig int) (*terraform.InstanceDiff, error) {
 	r := resourceClusterProfile()
 	state := &terraform.InstanceState{
 		ID: "cluster-profile-1",
@@ -1147,7 +1121,7 @@ func customizeDiffPackFixture(stateValues, newValues string, packCountInConfig i
 	if len(packs) > 0 {
 		cfg["pack"] = packs
 	}
-	return r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(cfg), unitTestMockAPIClient)
+	return r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(cfg), m)
 }
 
 // TestResourceClusterProfileCustomizeDiff_NoOpRefresh_WithPack_UnderFlag
@@ -1157,15 +1131,14 @@ func customizeDiffPackFixture(stateValues, newValues string, packCountInConfig i
 // must return no error -- the flag's guardrail should only fire on real
 // content edits.
 func TestResourceClusterProfileCustomizeDiff_NoOpRefresh_WithPack_UnderFlag(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{"immutable-clusterprofiles": true}
+	m := withTestFeaturePreview(unitTestMockAPIClient, "immutable-clusterprofiles")
 
 	// State value has trailing newline (API-normalized form). Config value
 	// doesn't (user HCL form). The existing DiffSuppressFunc on pack.values
 	// trims whitespace so this is a semantic no-op -- CustomizeDiff must
 	// agree.
 	_, err := customizeDiffPackFixture(
+		m,
 		"argocd:\n  version: 9.6.0\n",
 		"argocd:\n  version: 9.6.0",
 		1,
@@ -1177,12 +1150,11 @@ func TestResourceClusterProfileCustomizeDiff_NoOpRefresh_WithPack_UnderFlag(t *t
 // guards against over-suppressing: if the user genuinely edits pack.values,
 // the guardrail must still fire and mention `pack` in the diagnostic.
 func TestResourceClusterProfileCustomizeDiff_RealPackValuesEdit_UnderFlag(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{"immutable-clusterprofiles": true}
+	m := withTestFeaturePreview(unitTestMockAPIClient, "immutable-clusterprofiles")
 
 	// Not just whitespace -- the payload semantics change.
 	_, err := customizeDiffPackFixture(
+		m,
 		"argocd:\n  version: 9.6.0",
 		"argocd:\n  version: 9.7.0",
 		1,
@@ -1197,12 +1169,11 @@ func TestResourceClusterProfileCustomizeDiff_RealPackValuesEdit_UnderFlag(t *tes
 // length arm of the semantic compare: adding a pack element without a
 // version bump is a real content change and must still error.
 func TestResourceClusterProfileCustomizeDiff_PackAdded_UnderFlag(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{"immutable-clusterprofiles": true}
+	m := withTestFeaturePreview(unitTestMockAPIClient, "immutable-clusterprofiles")
 
 	// State has 1 pack (from fixture), config has 2.
 	_, err := customizeDiffPackFixture(
+		m,
 		"argocd:\n  version: 9.6.0",
 		"argocd:\n  version: 9.6.0",
 		2,
@@ -1215,11 +1186,10 @@ func TestResourceClusterProfileCustomizeDiff_PackAdded_UnderFlag(t *testing.T) {
 // symmetric case: removing a pack element without a version bump must also
 // error.
 func TestResourceClusterProfileCustomizeDiff_PackRemoved_UnderFlag(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{"immutable-clusterprofiles": true}
+	m := withTestFeaturePreview(unitTestMockAPIClient, "immutable-clusterprofiles")
 
 	_, err := customizeDiffPackFixture(
+		m,
 		"argocd:\n  version: 9.6.0",
 		"argocd:\n  version: 9.6.0",
 		0,
@@ -1268,9 +1238,7 @@ func TestResourceClusterProfileDelete_NormalDestroy(t *testing.T) {
 // the immutable version-bump Create path (clone + overwrite) syncs
 // profile_variables from HCL via PATCH/PUT before pack update.
 func TestResourceClusterProfileCreate_ImmutableCloneUpdatesVariables(t *testing.T) {
-	orig := ProviderFeaturePreview
-	defer func() { ProviderFeaturePreview = orig }()
-	ProviderFeaturePreview = map[string]bool{"immutable-clusterprofiles": true}
+	m := withTestFeaturePreview(unitTestMockAPIClient, "immutable-clusterprofiles")
 
 	d := prepareBaseClusterProfileTestData()
 	_ = d.Set("name", "test-cluster-profile-1")
@@ -1278,7 +1246,7 @@ func TestResourceClusterProfileCreate_ImmutableCloneUpdatesVariables(t *testing.
 	_ = d.Set("type", "add-on")
 
 	var ctx context.Context
-	diags := resourceClusterProfileCreate(ctx, d, unitTestMockAPIClient)
+	diags := resourceClusterProfileCreate(ctx, d, m)
 	assert.Empty(t, diags)
 	assert.Equal(t, "cloned-profile-uid", d.Id())
 }
@@ -0,0 +1,550 @@
+package spectrocloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/palette-sdk-go/client"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+	"github.com/spectrocloud/terraform-provider-spectrocloud/types"
+)
+
+// clusterProfileDeprecatedLabel marks profile versions deprecated by the
+// retention of spectrocloud_cluster_profile_version. Palette shows labels as
+// profile tags.
+const clusterProfileDeprecatedLabel = "deprecated"
+
+func resourceClusterProfileVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterProfileVersionCreate,
+		ReadContext:   resourceClusterProfileVersionRead,
+		UpdateContext: resourceClusterProfileVersionUpdate,
+		DeleteContext: resourceClusterProfileVersionDelete,
+		Description: "Creates a new version of an existing cluster profile by cloning a base version and overriding pack tags and values. " +
+			"The profile lineage is identified by its name and context. Older versions of the lineage can be deprecated or deleted once more than `retain_versions` exist.",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the cluster profile lineage. At least one version of the profile must already exist.",
+			},
+			"context": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "project",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"", "project", "tenant", "system"}, false),
+				Description: "The context of the cluster profile. Allowed values are `project`, `tenant` or `system`. " +
+					"Default value is `project`. " + PROJECT_NAME_NUANCE,
+			},
+			"project": schemas.ProjectSchema(),
+			"version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The version to create. If the version already exists, it is adopted as is.",
+			},
+			"base_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Description: "The version to clone. Defaults to the highest existing version of the lineage. " +
+					"Profile variables are copied from the base version.",
+			},
+			"base_profile_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the profile version that was cloned.",
+			},
+			"pack_override": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Changes applied to the packs of the cloned version. Packs without an override are kept as they are in the base version.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the pack to override. The pack must exist in the base version.",
+						},
+						"tag": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The pack tag to use instead of the base version's. The pack is looked up again in its registry.",
+						},
+						"values": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The pack values to use instead of the base version's.",
+						},
+					},
+				},
+			},
+			"retain_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "The number of the newest versions of the lineage to keep, this version included. Older versions are handled according to `retention_action`. " +
+					"Versions that are not semantic versions are never pruned, nor is the base version, which is usually managed by a `spectrocloud_cluster_profile` resource. " +
+					"Default value is `0`, which keeps every version.",
+			},
+			"retention_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "deprecate",
+				ValidateFunc: validation.StringInSlice([]string{"deprecate", "delete"}, false),
+				Description: "What to do with versions beyond `retain_versions`. `deprecate` tags them `deprecated:true`, `delete` deletes them unless a cluster still uses them. " +
+					"Default value is `deprecate`.",
+			},
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "When `true`, destroying the resource removes it from Terraform state without deleting the profile version from Palette. " +
+					"Default value is `false`.",
+			},
+		},
+	}
+}
+
+func resourceClusterProfileVersionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+
+	profiles, err := clusterProfileVersionsInContext(c, name, d.Get("context").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Same adopt-on-create pattern as the immutable-clusterprofiles path of
+	// spectrocloud_cluster_profile.
+	if existing := findClusterProfileVersion(profiles, version); existing != nil {
+		existingUID := existing.Metadata.UID
+		log.Printf("cluster profile %s version %s already exists (UID %s), adopting", name, version, existingUID)
+		// Record the version it would have been cloned from so retention
+		// leaves it alone. There is none when it is the only version.
+		var others []*models.V1ClusterProfileMetadata
+		for _, p := range profiles {
+			if p.Metadata.UID != existingUID {
+				others = append(others, p)
+			}
+		}
+		if len(others) > 0 || d.Get("base_version").(string) != "" {
+			baseUID, baseVersion, err := findClusterProfileBaseVersion(others, name, d.Get("base_version").(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set("base_version", baseVersion); err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set("base_profile_id", baseUID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		d.SetId(existingUID)
+		return append(applyClusterProfileRetention(c, d), resourceClusterProfileVersionRead(ctx, d, m)...)
+	}
+
+	baseUID, baseVersion, err := findClusterProfileBaseVersion(profiles, name, d.Get("base_version").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("cloning cluster profile %s version %s (UID %s) to create version %s", name, baseVersion, baseUID, version)
+	newUID, err := c.CloneClusterProfile(baseUID, &models.V1ClusterProfileCloneEntity{
+		Metadata: &models.V1ClusterProfileCloneMetaInputEntity{
+			Name:    &name,
+			Version: version,
+		},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(newUID)
+	if err := d.Set("base_version", baseVersion); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("base_profile_id", baseUID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if overrides := d.Get("pack_override").([]interface{}); len(overrides) > 0 {
+		cp, err := c.GetClusterProfile(newUID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if cp == nil || cp.Spec == nil || cp.Spec.Published == nil {
+			return diag.FromErr(fmt.Errorf("cluster profile %s not found after cloning", newUID))
+		}
+		update, err := toClusterProfileVersionUpdate(c, newUID, cp, overrides)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := c.UpdateClusterProfile(update); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := c.PublishClusterProfile(newUID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return append(applyClusterProfileRetention(c, d), resourceClusterProfileVersionRead(ctx, d, m)...)
+}
+
+func resourceClusterProfileVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	var diags diag.Diagnostics
+	cp, err := c.GetClusterProfile(d.Id())
+	if err != nil {
+		return handleReadError(d, err, diags)
+	} else if cp == nil || cp.Metadata == nil || cp.Spec == nil {
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("name", cp.Metadata.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("version", cp.Spec.Version); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+// resourceClusterProfileVersionUpdate only runs for the retention and
+// skip_destroy arguments; everything else replaces the version.
+func resourceClusterProfileVersionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	var diags diag.Diagnostics
+	if d.HasChanges("retain_versions", "retention_action") {
+		diags = applyClusterProfileRetention(c, d)
+	}
+	return append(diags, resourceClusterProfileVersionRead(ctx, d, m)...)
+}
+
+func resourceClusterProfileVersionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.Get("skip_destroy").(bool) {
+		log.Printf("skip_destroy: removing cluster profile version %s from Terraform state without deleting it from Palette", d.Id())
+		return nil
+	}
+
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+	if err := c.DeleteClusterProfile(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// clusterProfileVersionsInContext lists the versions of lineage name that
+// belong to profileContext. The listing carries no scope and a project
+// listing includes tenant and system profiles, so each version of the lineage
+// is fetched to read its scope annotation.
+func clusterProfileVersionsInContext(c *client.V1Client, name, profileContext string) ([]*models.V1ClusterProfileMetadata, error) {
+	if profileContext == "" {
+		profileContext = "project"
+	}
+	profiles, err := c.GetClusterProfiles()
+	if err != nil {
+		return nil, err
+	}
+	var out []*models.V1ClusterProfileMetadata
+	for _, p := range profiles {
+		if p == nil || p.Metadata == nil || p.Spec == nil || p.Metadata.Name != name {
+			continue
+		}
+		cp, err := c.GetClusterProfile(p.Metadata.UID)
+		if err != nil {
+			return nil, err
+		}
+		if cp == nil || cp.Metadata == nil || cp.Metadata.Annotations["scope"] != profileContext {
+			continue
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// findClusterProfileVersion returns the entry of profiles for version, or nil.
+func findClusterProfileVersion(profiles []*models.V1ClusterProfileMetadata, version string) *models.V1ClusterProfileMetadata {
+	for _, p := range profiles {
+		if p.Spec.Version == version {
+			return p
+		}
+	}
+	return nil
+}
+
+// findClusterProfileBaseVersion returns the UID and version of the version of
+// lineage name to clone out of profiles, as listed by
+// clusterProfileVersionsInContext: baseVersion when set, otherwise the
+// highest semantic version, falling back to any version when none of them is
+// a semantic version.
+func findClusterProfileBaseVersion(profiles []*models.V1ClusterProfileMetadata, name, baseVersion string) (string, string, error) {
+	if baseVersion != "" {
+		p := findClusterProfileVersion(profiles, baseVersion)
+		if p == nil {
+			return "", "", fmt.Errorf("base version %s of cluster profile %s not found", baseVersion, name)
+		}
+		return p.Metadata.UID, baseVersion, nil
+	}
+
+	if lineage := clusterProfileLineage(profiles, name); len(lineage) > 0 {
+		return lineage[0].Metadata.UID, lineage[0].Spec.Version, nil
+	}
+	if len(profiles) > 0 {
+		return profiles[0].Metadata.UID, profiles[0].Spec.Version, nil
+	}
+	return "", "", fmt.Errorf("no version of cluster profile %s exists to clone; create the first version with spectrocloud_cluster_profile", name)
+}
+
+// clusterProfileLineage returns the versions of the profiles named name that
+// are semantic versions, highest first.
+func clusterProfileLineage(profiles []*models.V1ClusterProfileMetadata, name string) []*models.V1ClusterProfileMetadata {
+	type versioned struct {
+		profile *models.V1ClusterProfileMetadata
+		version *semver.Version
+	}
+	var lineage []versioned
+	for _, p := range profiles {
+		if p == nil || p.Metadata == nil || p.Spec == nil || p.Metadata.Name != name {
+			continue
+		}
+		v, err := semver.NewVersion(p.Spec.Version)
+		if err != nil {
+			continue
+		}
+		lineage = append(lineage, versioned{p, v})
+	}
+	sort.SliceStable(lineage, func(i, j int) bool { return lineage[i].version.GreaterThan(lineage[j].version) })
+
+	out := make([]*models.V1ClusterProfileMetadata, 0, len(lineage))
+	for _, l := range lineage {
+		out = append(out, l.profile)
+	}
+	return out
+}
+
+// clusterProfileVersionsToPrune returns the versions of lineage name beyond
+// the newest retain. keepUID, the version the resource manages, counts
+// towards retain and is never pruned, even when it is not the newest.
+// skipUIDs are versions managed by other resources, such as the base version
+// owned by a spectrocloud_cluster_profile; they are left alone and do not
+// count towards retain.
+func clusterProfileVersionsToPrune(profiles []*models.V1ClusterProfileMetadata, name, keepUID string, retain int, skipUIDs ...string) []*models.V1ClusterProfileMetadata {
+	if retain <= 0 {
+		return nil
+	}
+	skip := make(map[string]bool, len(skipUIDs))
+	for _, uid := range skipUIDs {
+		skip[uid] = true
+	}
+	var prune []*models.V1ClusterProfileMetadata
+	kept := 1
+	for _, p := range clusterProfileLineage(profiles, name) {
+		if p.Metadata.UID == keepUID || skip[p.Metadata.UID] {
+			continue
+		}
+		if kept < retain {
+			kept++
+			continue
+		}
+		prune = append(prune, p)
+	}
+	return prune
+}
+
+// applyClusterProfileRetention deprecates or deletes the versions beyond
+// retain_versions. The new version already exists at this point, so failures
+// are reported as warnings rather than failing the apply.
+func applyClusterProfileRetention(c *client.V1Client, d *schema.ResourceData) diag.Diagnostics {
+	retain := d.Get("retain_versions").(int)
+	if retain <= 0 {
+		return nil
+	}
+	name := d.Get("name").(string)
+	profiles, err := clusterProfileVersionsInContext(c, name, d.Get("context").(string))
+	if err != nil {
+		return clusterProfileRetentionWarning(name, err)
+	}
+
+	var diags diag.Diagnostics
+	for _, p := range clusterProfileVersionsToPrune(profiles, name, d.Id(), retain, d.Get("base_profile_id").(string)) {
+		uid, version := p.Metadata.UID, p.Spec.Version
+		var err error
+		if d.Get("retention_action").(string) == "delete" {
+			err = deleteUnusedClusterProfileVersion(c, uid)
+		} else {
+			err = deprecateClusterProfileVersion(c, uid)
+		}
+		if err != nil {
+			diags = append(diags, clusterProfileRetentionWarning(name, fmt.Errorf("version %s: %w", version, err))...)
+		}
+	}
+	return diags
+}
+
+func clusterProfileRetentionWarning(name string, err error) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Unable to prune old cluster profile versions",
+		Detail:   fmt.Sprintf("Cluster profile %s: %s", name, err),
+	}}
+}
+
+func deprecateClusterProfileVersion(c *client.V1Client, uid string) error {
+	cp, err := c.GetClusterProfile(uid)
+	if err != nil {
+		return err
+	}
+	if cp == nil || cp.Metadata == nil || cp.Spec == nil {
+		return nil
+	}
+	if cp.Metadata.Labels[clusterProfileDeprecatedLabel] == "true" {
+		return nil
+	}
+	labels := make(map[string]string, len(cp.Metadata.Labels)+1)
+	for k, v := range cp.Metadata.Labels {
+		labels[k] = v
+	}
+	labels[clusterProfileDeprecatedLabel] = "true"
+
+	log.Printf("deprecating cluster profile %s version %s (UID %s)", cp.Metadata.Name, cp.Spec.Version, uid)
+	return c.PatchClusterProfile(&models.V1ClusterProfileUpdateEntity{Metadata: &models.V1ObjectMeta{UID: uid}}, &models.V1ProfileMetaEntity{
+		Metadata: &models.V1ObjectMetaInputEntity{
+			Name:        cp.Metadata.Name,
+			Annotations: map[string]string{"description": cp.Metadata.Annotations["description"]},
+			Labels:      labels,
+		},
+		Spec: &models.V1ClusterProfileSpecEntity{
+			Version: cp.Spec.Version,
+		},
+	})
+}
+
+func deleteUnusedClusterProfileVersion(c *client.V1Client, uid string) error {
+	cp, err := c.GetClusterProfile(uid)
+	if err != nil {
+		return err
+	}
+	if cp == nil {
+		return nil
+	}
+	if cp.Status != nil && (len(cp.Status.InUseClusters) > 0 || len(cp.Status.InUseClusterUids) > 0) {
+		return fmt.Errorf("not deleted, it is still used by clusters")
+	}
+	log.Printf("deleting cluster profile version (UID %s)", uid)
+	return c.DeleteClusterProfile(uid)
+}
+
+// toClusterProfileVersionUpdate rebuilds the packs of the cloned profile uid with
+// the pack overrides applied. Packs go through the same conversion as the
+// pack blocks of spectrocloud_cluster_profile, so an overridden tag is
+// resolved to its pack UID in the pack's registry.
+func toClusterProfileVersionUpdate(c *client.V1Client, uid string, cp *models.V1ClusterProfile, overrides []interface{}) (*models.V1ClusterProfileUpdateEntity, error) {
+	byName := make(map[string]map[string]interface{}, len(overrides))
+	for _, o := range overrides {
+		override := o.(map[string]interface{})
+		byName[override["name"].(string)] = override
+	}
+
+	packs := make([]*models.V1PackManifestUpdateEntity, 0, len(cp.Spec.Published.Packs))
+	for _, p := range cp.Spec.Published.Packs {
+		if p == nil || p.Name == nil {
+			continue
+		}
+		pack := map[string]interface{}{
+			"name":         *p.Name,
+			"tag":          p.Tag,
+			"uid":          p.PackUID,
+			"registry_uid": p.RegistryUID,
+			"type":         p.Type,
+			"values":       p.Values,
+			"manifest":     []interface{}{},
+		}
+		if len(p.Manifests) > 0 {
+			content, err := c.GetClusterProfileManifestPack(uid, *p.Name)
+			if err != nil {
+				return nil, err
+			}
+			manifests := make([]interface{}, 0, len(content))
+			for _, m := range content {
+				if m == nil || m.Metadata == nil || m.Spec == nil || m.Spec.Published == nil {
+					continue
+				}
+				manifests = append(manifests, map[string]interface{}{"name": m.Metadata.Name, "content": m.Spec.Published.Content})
+			}
+			pack["manifest"] = manifests
+		}
+		if override, ok := byName[*p.Name]; ok {
+			delete(byName, *p.Name)
+			if tag := override["tag"].(string); tag != "" && tag != p.Tag {
+				pack["tag"] = tag
+				pack["uid"] = ""
+			}
+			if values := override["values"].(string); values != "" {
+				pack["values"] = values
+			}
+		}
+		update, err := toClusterProfilePackUpdateWithResolution(pack, cp.Spec.Published.Packs, c)
+		if err != nil {
+			return nil, err
+		}
+		packs = append(packs, update)
+	}
+	if len(byName) > 0 {
+		missing := make([]string, 0, len(byName))
+		for name := range byName {
+			missing = append(missing, name)
+		}
+		sort.Strings(missing)
+		return nil, fmt.Errorf("pack_override: the base version has no pack named %s", strings.Join(missing, ", "))
+	}
+
+	return &models.V1ClusterProfileUpdateEntity{
+		Metadata: &models.V1ObjectMeta{
+			Name: cp.Metadata.Name,
+			UID:  uid,
+		},
+		Spec: &models.V1ClusterProfileUpdateEntitySpec{
+			Template: &models.V1ClusterProfileTemplateUpdate{
+				Type:  types.Ptr(models.V1ProfileType(cp.Spec.Published.Type)),
+				Packs: packs,
+			},
+			Version: cp.Spec.Version,
+		},
+	}, nil
+}
@@ -0,0 +1,114 @@
+package spectrocloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterProfileVersionsToPrune(t *testing.T) {
+	profile := func(name, uid, version string) *models.V1ClusterProfileMetadata {
+		return &models.V1ClusterProfileMetadata{
+			Metadata: &models.V1ObjectEntity{Name: name, UID: uid},
+			Spec:     &models.V1ClusterProfileMetadataSpec{Version: version},
+		}
+	}
+	profiles := []*models.V1ClusterProfileMetadata{
+		profile("infra", "v1", "1.0.0"),
+		profile("infra", "v3", "1.2.0"),
+		profile("infra", "v2", "1.1.0"),
+		profile("infra", "custom", "latest"),
+		profile("addons", "a1", "0.1.0"),
+		profile("infra", "v10", "1.10.0"),
+	}
+	uids := func(ps []*models.V1ClusterProfileMetadata) []string {
+		var out []string
+		for _, p := range ps {
+			out = append(out, p.Metadata.UID)
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{"v10", "v3", "v2", "v1"}, uids(clusterProfileLineage(profiles, "infra")), "versions sort semantically and non-semver versions are left out")
+
+	assert.Empty(t, clusterProfileVersionsToPrune(profiles, "infra", "v10", 0), "0 keeps every version")
+	assert.Equal(t, []string{"v2", "v1"}, uids(clusterProfileVersionsToPrune(profiles, "infra", "v10", 2)))
+	assert.Equal(t, []string{"v3", "v1"}, uids(clusterProfileVersionsToPrune(profiles, "infra", "v2", 2)), "the managed version is kept even when it is not the newest")
+	assert.Equal(t, []string{"v3", "v2", "v1"}, uids(clusterProfileVersionsToPrune(profiles, "infra", "not-listed-yet", 2)))
+	assert.Equal(t, []string{"v2"}, uids(clusterProfileVersionsToPrune(profiles, "infra", "v10", 2, "v1")), "the base version is never pruned")
+	assert.Equal(t, []string{"v1"}, uids(clusterProfileVersionsToPrune(profiles, "infra", "v10", 2, "v3")), "the base version does not count towards retain")
+}
+
+func TestResourceClusterProfileVersionCreate(t *testing.T) {
+	d := resourceClusterProfileVersion().TestResourceData()
+	_ = d.Set("name", "test-cluster-profile-1")
+	_ = d.Set("version", "1.1.0")
+	_ = d.Set("retain_versions", 1)
+	_ = d.Set("pack_override", []interface{}{
+		map[string]interface{}{"name": "k8", "tag": "", "values": "replicas: 2"},
+	})
+	diags := resourceClusterProfileVersionCreate(context.Background(), d, unitTestMockAPIClient)
+	require.Empty(t, diags)
+	assert.Equal(t, "cloned-profile-uid", d.Id())
+	assert.Equal(t, "1.0.0", d.Get("base_version"))
+	assert.Equal(t, "cluster-profile-import-1", d.Get("base_profile_id"))
+
+	d = resourceClusterProfileVersion().TestResourceData()
+	_ = d.Set("name", "test-cluster-profile-1")
+	_ = d.Set("version", "1.1.0")
+	_ = d.Set("pack_override", []interface{}{
+		map[string]interface{}{"name": "nginx", "tag": "1.0.0", "values": ""},
+	})
+	diags = resourceClusterProfileVersionCreate(context.Background(), d, unitTestMockAPIClient)
+	assertFirstDiagMessage(t, diags, "pack_override: the base version has no pack named nginx")
+}
+
+func TestResourceClusterProfileVersionCreateAdoptsExistingVersion(t *testing.T) {
+	d := resourceClusterProfileVersion().TestResourceData()
+	_ = d.Set("name", "test-cluster-profile-1")
+	_ = d.Set("version", "1.0.0")
+	_ = d.Set("retain_versions", 1)
+	_ = d.Set("retention_action", "delete")
+	diags := resourceClusterProfileVersionCreate(context.Background(), d, unitTestMockAPIClient)
+	require.Empty(t, diags)
+	assert.Equal(t, "cluster-profile-import-1", d.Id())
+	assert.Equal(t, "0.9.0", d.Get("base_version"), "the base is the highest other version of the project lineage")
+	assert.Equal(t, "cluster-profile-previous", d.Get("base_profile_id"))
+}
+
+func TestClusterProfileVersionsInContext(t *testing.T) {
+	uids := func(profileContext string) []string {
+		c := getV1ClientWithResourceContext(unitTestMockAPIClient, profileContext)
+		profiles, err := clusterProfileVersionsInContext(c, "test-cluster-profile-1", profileContext)
+		require.NoError(t, err)
+		var out []string
+		for _, p := range profiles {
+			out = append(out, p.Metadata.UID)
+		}
+		return out
+	}
+	assert.Equal(t, []string{"cluster-profile-import-1", "cluster-profile-previous"}, uids("project"))
+	assert.Equal(t, []string{"cluster-profile-import-1", "cluster-profile-previous"}, uids(""), "an empty context is the project context")
+	assert.Equal(t, []string{"cluster-profile-tenant"}, uids("tenant"))
+}
+
+func TestResourceClusterProfileVersionCreateWithoutLineage(t *testing.T) {
+	d := resourceClusterProfileVersion().TestResourceData()
+	_ = d.Set("name", "no-such-profile")
+	_ = d.Set("version", "1.0.0")
+	diags := resourceClusterProfileVersionCreate(context.Background(), d, unitTestMockAPIClient)
+	assertFirstDiagMessage(t, diags, "no version of cluster profile no-such-profile exists to clone; create the first version with spectrocloud_cluster_profile")
+}
+
+func TestResourceClusterProfileVersionDelete(t *testing.T) {
+	d := resourceClusterProfileVersion().TestResourceData()
+	d.SetId("cluster-profile-import-1")
+	_ = d.Set("skip_destroy", true)
+	assert.Empty(t, resourceClusterProfileVersionDelete(context.Background(), d, unitTestMockAPINegativeClient), "skip_destroy never calls the API")
+
+	_ = d.Set("skip_destroy", false)
+	assert.Empty(t, resourceClusterProfileVersionDelete(context.Background(), d, unitTestMockAPIClient))
+}
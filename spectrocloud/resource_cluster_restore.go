@@ -0,0 +1,270 @@
+package spectrocloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/palette-sdk-go/client"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+)
+
+var resourceClusterRestorePendingStates = []string{
+	"",
+	"New",
+	"InProgress",
+	"WaitingForPluginOperations",
+	"Finalizing",
+}
+
+func resourceClusterRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterRestoreCreate,
+		ReadContext:   resourceClusterRestoreRead,
+		UpdateContext: resourceClusterRestoreUpdate,
+		DeleteContext: resourceClusterRestoreDelete,
+		Description: "Restores a backup of a cluster, into the same cluster or into a different one. " +
+			"A restore can't be undone: destroying the resource only removes it from Terraform state.",
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The UID of the cluster the backup was taken from.",
+			},
+			"context": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "project",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"project", "tenant"}, false),
+				Description: "The context of the clusters. Allowed values are `project` or `tenant`. " +
+					"Default value is `project`. " + PROJECT_NAME_NUANCE,
+			},
+			"project": schemas.ProjectSchema(),
+			"backup_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the backup to restore, such as the `name` of a `spectrocloud_cluster_backup`.",
+			},
+			"backup_request_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The request UID of the backup to restore, such as the `id` of a `spectrocloud_cluster_backup`.",
+			},
+			"destination_cluster_uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The UID of the cluster to restore into. Defaults to `cluster_uid`, the cluster the backup was taken from.",
+			},
+			"namespaces": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The namespaces of the backup to restore. If not specified, every namespace of the backup is restored. " +
+					"Namespaces are restored under their original names: Palette's restore API has no way to map them to other namespaces.",
+			},
+			"include_cluster_resources_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "auto",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"always", "never", "auto"}, false),
+				Description:  "Whether to restore the cluster-scoped resources of the backup. Supported values are `always`, `never`, and `auto`. Default value is `auto`.",
+			},
+			"restore_volumes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "Whether to restore persistent volumes from their backed-up disks. Default value is `true`.",
+			},
+			"preserve_node_ports": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Whether to keep the node ports of restored services. Default value is `false`.",
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Whether to wait for the restore to complete when it is created. A failed restore fails the apply. " +
+					"When `false`, creation only waits for Palette to list the restore. Default value is `true`.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the restore, such as `InProgress`, `Completed`, `PartiallyFailed` or `Failed`.",
+			},
+			"restore_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the restore finished, in RFC3339 format.",
+			},
+		},
+	}
+}
+
+func resourceClusterRestoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	clusterUID := d.Get("cluster_uid").(string)
+	destinationUID := d.Get("destination_cluster_uid").(string)
+	if destinationUID == "" {
+		destinationUID = clusterUID
+	}
+	if err := d.Set("destination_cluster_uid", destinationUID); err != nil {
+		return diag.FromErr(err)
+	}
+	backupName := d.Get("backup_name").(string)
+	backupRequestUID := d.Get("backup_request_uid").(string)
+	namespaces := make([]string, 0)
+	for _, ns := range d.Get("namespaces").(*schema.Set).List() {
+		namespaces = append(namespaces, ns.(string))
+	}
+	config := &models.V1ClusterRestoreConfig{
+		BackupName:                 &backupName,
+		BackupRequestUID:           &backupRequestUID,
+		DestinationClusterUID:      &destinationUID,
+		IncludeClusterResourceMode: convertIncludeResourceMode(d.Get("include_cluster_resources_mode").(string)),
+		IncludeNamespaces:          namespaces,
+		PreserveNodePorts:          d.Get("preserve_node_ports").(bool),
+		RestorePVs:                 d.Get("restore_volumes").(bool),
+	}
+
+	log.Printf("restoring backup %s of cluster %s into cluster %s", backupName, clusterUID, destinationUID)
+	uid, err := c.CreateClusterRestoreConfigOnDemand(clusterUID, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if uid == nil || uid.UID == nil {
+		return diag.FromErr(fmt.Errorf("restore of backup %s: Palette returned no restore request UID", backupName))
+	}
+	d.SetId(*uid.UID)
+
+	if d.Get("wait_for_completion").(bool) {
+		stateConf := &retry.StateChangeConf{
+			Pending: resourceClusterRestorePendingStates,
+			Target:  []string{clusterBackupStateCompleted},
+			Refresh: resourceClusterRestoreStateRefreshFunc(c, destinationUID, d.Id(), backupName),
+			Timeout: d.Timeout(schema.TimeoutCreate) - 1*time.Minute,
+		}
+		waitSettingsFor(m, d).apply(stateConf)
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return append(diag.FromErr(err), resourceClusterRestoreRead(ctx, d, m)...)
+		}
+	} else if err := waitForClusterBackupListed(ctx, m, d, resourceClusterRestoreStateRefreshFunc(c, destinationUID, d.Id(), backupName)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceClusterRestoreRead(ctx, d, m)
+}
+
+func resourceClusterRestoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	var diags diag.Diagnostics
+	restore, err := getClusterRestoreStatus(c, d.Get("destination_cluster_uid").(string), d.Id())
+	if err != nil {
+		return handleReadError(d, err, diags)
+	} else if restore == nil {
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("state", restore.State); err != nil {
+		return diag.FromErr(err)
+	}
+	restoreTime := ""
+	if restore.RestoreStatusMeta != nil {
+		restoreTime = formatV1Time(restore.RestoreStatusMeta.RestoreTime)
+	}
+	if err := d.Set("restore_time", restoreTime); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+// resourceClusterRestoreUpdate only runs for wait_for_completion; everything
+// else restores the backup again.
+func resourceClusterRestoreUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceClusterRestoreRead(ctx, d, m)
+}
+
+// resourceClusterRestoreDelete only removes the restore from state: Palette
+// can't roll a restore back.
+func resourceClusterRestoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("removing restore %s of backup %s from Terraform state, the restored resources are kept", d.Id(), d.Get("backup_name").(string))
+	return nil
+}
+
+func resourceClusterRestoreStateRefreshFunc(c *client.V1Client, destinationUID, requestUID, backupName string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		restore, err := getClusterRestoreStatus(c, destinationUID, requestUID)
+		if err != nil {
+			return nil, "", err
+		} else if restore == nil {
+			// The restore can take a moment to be listed.
+			return nil, "", nil
+		}
+
+		log.Printf("Restore of backup %s state (%s): %s", backupName, destinationUID, restore.State)
+		if restore.State == clusterBackupStateFailed || restore.State == clusterBackupStatePartiallyFailed {
+			msg := ""
+			if restore.RestoreStatusMeta != nil {
+				msg = restore.RestoreStatusMeta.Msg
+			}
+			return restore, restore.State, clusterBackupStateError(fmt.Sprintf("restore of backup %s into cluster %s", backupName, destinationUID), restore.State, msg)
+		}
+		return restore, restore.State, nil
+	}
+}
+
+// getClusterRestoreStatus finds a restore into a cluster by its request UID.
+// It returns nil when the cluster has no such restore.
+func getClusterRestoreStatus(c *client.V1Client, destinationUID, requestUID string) (*models.V1ClusterRestoreStatusMeta, error) {
+	restores, err := c.GetClusterRestoreConfigOnDemand(destinationUID)
+	if err != nil {
+		return nil, err
+	}
+	if restores == nil || restores.Status == nil {
+		return nil, nil
+	}
+	for _, restore := range restores.Status.ClusterRestoreStatuses {
+		if restore != nil && restore.RestoreRequestUID == requestUID {
+			return restore, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,64 @@
+package spectrocloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceClusterRestoreCreate(t *testing.T) {
+	d := resourceClusterRestore().TestResourceData()
+	_ = d.Set("cluster_uid", clusterBackupUID)
+	_ = d.Set("backup_name", "pre-upgrade")
+	_ = d.Set("backup_request_uid", "backup-request-uid")
+	_ = d.Set("wait_for_completion", true)
+	_ = d.Set("namespaces", []interface{}{"app"})
+	diags := resourceClusterRestoreCreate(context.Background(), d, unitTestMockAPIClient)
+	require.Empty(t, diags)
+	assert.Equal(t, "restore-request-uid", d.Id())
+	assert.Equal(t, clusterBackupUID, d.Get("destination_cluster_uid"), "restores into the source cluster by default")
+	assert.Equal(t, "Completed", d.Get("state"))
+	assert.Equal(t, "2026-10-01T13:00:00Z", d.Get("restore_time"))
+
+	d = resourceClusterRestore().TestResourceData()
+	_ = d.Set("cluster_uid", clusterBackupUID)
+	_ = d.Set("destination_cluster_uid", "test-cluster-id")
+	_ = d.Set("backup_name", "broken")
+	_ = d.Set("backup_request_uid", "backup-request-failed-uid")
+	_ = d.Set("wait_for_completion", true)
+	diags = resourceClusterRestoreCreate(context.Background(), d, unitTestMockAPIClient)
+	assertFirstDiagMessage(t, diags, "restore of backup broken into cluster test-cluster-id: PartiallyFailed: 2 items failed to restore")
+	assert.Equal(t, "test-cluster-id", d.Get("destination_cluster_uid"))
+	assert.Equal(t, "PartiallyFailed", d.Get("state"))
+}
+
+func TestResourceClusterRestoreCreateWithoutWait(t *testing.T) {
+	meta := *unitTestMockAPIClient.(*providerMeta)
+	meta.wait.pollInterval = 10 * time.Millisecond
+
+	// The mock leaves the new request out of its first listing.
+	d := resourceClusterRestore().TestResourceData()
+	_ = d.Set("cluster_uid", clusterBackupUID)
+	_ = d.Set("backup_name", "listed-late")
+	_ = d.Set("backup_request_uid", "backup-request-late-uid")
+	_ = d.Set("wait_for_completion", false)
+	diags := resourceClusterRestoreCreate(context.Background(), d, &meta)
+	require.Empty(t, diags)
+	assert.Equal(t, "restore-request-late-uid", d.Id(), "a restore Palette has not listed yet stays in state")
+	assert.Equal(t, "InProgress", d.Get("state"))
+}
+
+func TestResourceClusterRestoreReadAndDelete(t *testing.T) {
+	d := resourceClusterRestore().TestResourceData()
+	d.SetId("unknown-restore-request-uid")
+	_ = d.Set("cluster_uid", clusterBackupUID)
+	_ = d.Set("destination_cluster_uid", clusterBackupUID)
+	require.Empty(t, resourceClusterRestoreRead(context.Background(), d, unitTestMockAPIClient))
+	assert.Empty(t, d.Id(), "a restore that is no longer listed is removed from state")
+
+	d.SetId("restore-request-uid")
+	assert.Empty(t, resourceClusterRestoreDelete(context.Background(), d, unitTestMockAPINegativeClient), "delete never calls the API")
+}
@@ -0,0 +1,249 @@
+package spectrocloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"github.com/spectrocloud/palette-sdk-go/client"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+)
+
+// clusterScanStatePending is the aggregate state of the scans of a
+// spectrocloud_cluster_scan until Palette lists a new scan of each type.
+const clusterScanStatePending = ""
+
+var resourceClusterScanPendingStates = []string{
+	clusterScanStatePending,
+	"InProgress",
+}
+
+var resourceClusterScanTypes = []string{"configuration_scan", "penetration_scan", "conformance_scan"}
+
+func resourceClusterScan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterScanCreate,
+		ReadContext:   resourceClusterScanRead,
+		UpdateContext: resourceClusterScanUpdate,
+		DeleteContext: resourceClusterScanDelete,
+		Description: "Runs compliance scans of a cluster on demand, for example after an upgrade, and waits for their reports. " +
+			"Scheduled scans are configured with the `scan_policy` block of the cluster resources instead. " +
+			"Destroying the resource only removes it from Terraform state; the reports stay in Palette.",
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The UID of the cluster to scan.",
+			},
+			"context": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "project",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"project", "tenant"}, false),
+				Description: "The context of the cluster. Allowed values are `project` or `tenant`. " +
+					"Default value is `project`. " + PROJECT_NAME_NUANCE,
+			},
+			"project": schemas.ProjectSchema(),
+			"configuration_scan": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				ForceNew:     true,
+				AtLeastOneOf: resourceClusterScanTypes,
+				Description:  "Whether to run a configuration scan, which checks the cluster against the CIS Kubernetes Benchmark with kube-bench.",
+			},
+			"penetration_scan": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				ForceNew:     true,
+				AtLeastOneOf: resourceClusterScanTypes,
+				Description:  "Whether to run a penetration scan, which looks for security weaknesses in the cluster with kube-hunter.",
+			},
+			"conformance_scan": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				ForceNew:     true,
+				AtLeastOneOf: resourceClusterScanTypes,
+				Description:  "Whether to run a conformance scan, which runs the Kubernetes conformance tests with Sonobuoy.",
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that run the scans again when they change, such as the version of a cluster profile. " +
+					"Without triggers, the scans only run when the resource is created.",
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Whether to wait for the scans to complete when they are started. A failed scan fails the apply, " +
+					"but failed checks do not. Default value is `true`.",
+			},
+			"reports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "The latest report of each scan type the resource runs, in the order `configuration`, `penetration`, " +
+					"`conformance`. Once the scans complete, these are the reports of the scans the resource ran, until other scans of the cluster run.",
+				Elem: clusterScanReportSchema(),
+			},
+		},
+	}
+}
+
+func resourceClusterScanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	clusterUID := d.Get("cluster_uid").(string)
+	types := resourceClusterScanRequestedTypes(d)
+
+	// Palette doesn't return the request UIDs of the scans it starts, so the
+	// new scans are the ones listed with a request UID other than these.
+	logs, err := c.GetComplianceScanOnDemandScanLogs(clusterUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	previous := map[string]string{}
+	for t, r := range latestClusterScanReports(logs) {
+		previous[t] = r.requestUID
+	}
+
+	log.Printf("running %s scans of cluster %s", strings.Join(types, ", "), clusterUID)
+	if err := c.CreateComplianceScanOnDemandCreateClusterScanConfig(clusterUID, toClusterScanOnDemandConfig(types)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(clusterUID)
+
+	if d.Get("wait_for_completion").(bool) {
+		stateConf := &retry.StateChangeConf{
+			Pending: resourceClusterScanPendingStates,
+			Target:  []string{clusterScanStateCompleted},
+			Refresh: resourceClusterScanStateRefreshFunc(c, clusterUID, types, previous),
+			Timeout: d.Timeout(schema.TimeoutCreate) - 1*time.Minute,
+		}
+		waitSettingsFor(m, d).apply(stateConf)
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return append(diag.FromErr(err), resourceClusterScanRead(ctx, d, m)...)
+		}
+	}
+
+	return resourceClusterScanRead(ctx, d, m)
+}
+
+func resourceClusterScanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c := getV1ClientWithResourceContext(m, d.Get("context").(string))
+
+	var diags diag.Diagnostics
+	logs, err := c.GetComplianceScanOnDemandScanLogs(d.Get("cluster_uid").(string))
+	if err != nil {
+		return handleReadError(d, err, diags)
+	}
+
+	reports := flattenClusterScanReports(latestClusterScanReports(logs), resourceClusterScanRequestedTypes(d))
+	if err := d.Set("reports", reports); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+// resourceClusterScanUpdate only runs for wait_for_completion; everything
+// else runs the scans again.
+func resourceClusterScanUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceClusterScanRead(ctx, d, m)
+}
+
+func resourceClusterScanDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("removing scans of cluster %s from Terraform state; their reports stay in Palette", d.Get("cluster_uid").(string))
+	return nil
+}
+
+// resourceClusterScanRequestedTypes returns the scan types a
+// spectrocloud_cluster_scan runs, in the order of clusterScanTypes.
+func resourceClusterScanRequestedTypes(d *schema.ResourceData) []string {
+	var types []string
+	for _, t := range clusterScanTypes {
+		if d.Get(t + "_scan").(bool) {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+func toClusterScanOnDemandConfig(types []string) *models.V1ClusterComplianceOnDemandConfig {
+	config := &models.V1ClusterComplianceOnDemandConfig{}
+	for _, t := range types {
+		switch t {
+		case clusterScanConfiguration:
+			config.KubeBench = &models.V1ClusterComplianceScanKubeBenchConfig{RunScan: true}
+		case clusterScanPenetration:
+			config.KubeHunter = &models.V1ClusterComplianceScanKubeHunterConfig{RunScan: true}
+		case clusterScanConformance:
+			config.Sonobuoy = &models.V1ClusterComplianceScanSonobuoyConfig{RunScan: true}
+		}
+	}
+	return config
+}
+
+// resourceClusterScanStateRefreshFunc reports the aggregate state of the
+// scans of types started after the scans whose request UIDs are in previous:
+// pending until each type has a new scan, then in progress until they all
+// complete. A failed scan is an error.
+func resourceClusterScanStateRefreshFunc(c *client.V1Client, clusterUID string, types []string, previous map[string]string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		logs, err := c.GetComplianceScanOnDemandScanLogs(clusterUID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		reports := latestClusterScanReports(logs)
+		state := clusterScanStateCompleted
+		for _, t := range types {
+			r, ok := reports[t]
+			if !ok || r.requestUID == previous[t] {
+				state = clusterScanStatePending
+				continue
+			}
+			log.Printf("%s scan %s state (%s): %s", t, r.requestUID, clusterUID, r.state)
+			switch r.state {
+			case clusterScanStateCompleted:
+			case clusterScanStateFailed:
+				if r.message == "" {
+					return reports, r.state, fmt.Errorf("%s scan of cluster %s: %s", t, clusterUID, r.state)
+				}
+				return reports, r.state, fmt.Errorf("%s scan of cluster %s: %s: %s", t, clusterUID, r.state, r.message)
+			default:
+				if state == clusterScanStateCompleted {
+					state = "InProgress"
+				}
+			}
+		}
+		return reports, state, nil
+	}
+}
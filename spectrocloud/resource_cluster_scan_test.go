@@ -0,0 +1,63 @@
+package spectrocloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceClusterScanCreate(t *testing.T) {
+	d := resourceClusterScan().TestResourceData()
+	_ = d.Set("cluster_uid", "cluster-uid-scan-on-demand")
+	_ = d.Set("configuration_scan", true)
+	_ = d.Set("penetration_scan", true)
+	_ = d.Set("wait_for_completion", true)
+	require.Empty(t, resourceClusterScanCreate(context.Background(), d, unitTestMockAPIClient))
+	assert.Equal(t, "cluster-uid-scan-on-demand", d.Id())
+
+	reports := d.Get("reports").([]interface{})
+	require.Len(t, reports, 2, "only the scan types the resource runs")
+	configuration := reports[0].(map[string]interface{})
+	assert.Equal(t, "configuration", configuration["type"])
+	assert.Regexp(t, "^scan-request-", configuration["request_uid"], "the report of the new scan")
+	assert.Equal(t, "Completed", configuration["state"])
+	assert.Equal(t, []interface{}{"1.2.16"}, configuration["failed_checks"])
+	penetration := reports[1].(map[string]interface{})
+	assert.Equal(t, "penetration", penetration["type"])
+	assert.Equal(t, configuration["request_uid"], penetration["request_uid"])
+}
+
+func TestResourceClusterScanCreateFailed(t *testing.T) {
+	d := resourceClusterScan().TestResourceData()
+	_ = d.Set("cluster_uid", "cluster-uid-scan-failed")
+	_ = d.Set("configuration_scan", true)
+	_ = d.Set("wait_for_completion", true)
+	diags := resourceClusterScanCreate(context.Background(), d, unitTestMockAPIClient)
+	assertFirstDiagMessage(t, diags, "configuration scan of cluster cluster-uid-scan-failed: Failed: kube-bench job failed")
+	assert.Equal(t, "Failed", d.Get("reports").([]interface{})[0].(map[string]interface{})["state"])
+}
+
+func TestResourceClusterScanReadAndDelete(t *testing.T) {
+	d := resourceClusterScan().TestResourceData()
+	d.SetId("cluster-uid-scan")
+	_ = d.Set("cluster_uid", "cluster-uid-scan")
+	_ = d.Set("conformance_scan", true)
+	require.Empty(t, resourceClusterScanRead(context.Background(), d, unitTestMockAPIClient))
+	reports := d.Get("reports").([]interface{})
+	require.Len(t, reports, 1)
+	assert.Equal(t, "sonobuoy-request", reports[0].(map[string]interface{})["request_uid"])
+
+	assert.Empty(t, resourceClusterScanDelete(context.Background(), d, unitTestMockAPINegativeClient), "delete never calls the API")
+}
+
+func TestToClusterScanOnDemandConfig(t *testing.T) {
+	config := toClusterScanOnDemandConfig([]string{"configuration", "conformance"})
+	require.NotNil(t, config.KubeBench)
+	assert.True(t, config.KubeBench.RunScan)
+	assert.Nil(t, config.KubeHunter)
+	require.NotNil(t, config.Sonobuoy)
+	assert.True(t, config.Sonobuoy.RunScan)
+	assert.Nil(t, config.Syft)
+}
@@ -40,6 +40,7 @@ func resourceClusterVirtual() *schema.Resource {
 				Version: 2,
 			},
 		},
+		CustomizeDiff: resourceClusterCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -55,6 +56,7 @@ func resourceClusterVirtual() *schema.Resource {
 				Description: "The context of the virtual cluster. Allowed values are `project` or `tenant`. " +
 					"Default is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -158,7 +160,10 @@ func resourceClusterVirtual() *schema.Resource {
 				ValidateFunc: validateTimezone,
 				Description:  "Defines the time zone used by this cluster to interpret scheduled operations. Maintenance tasks like upgrades will follow this time zone to ensure they run at the appropriate local time for the cluster. Must be in IANA timezone format (e.g., 'America/New_York', 'Asia/Kolkata', 'Europe/London').",
 			},
-			"renew_k8s_certificates_now": schemas.RenewK8sCertificatesNowSchema(),
+			"renew_k8s_certificates_now":          schemas.RenewK8sCertificatesNowSchema(),
+			"auto_renew_certificates_before_days": schemas.AutoRenewCertificatesBeforeDaysSchema(),
+			"k8s_certificates_expiry":             schemas.K8sCertificatesExpirySchema(),
+			"k8s_certificates":                    schemas.K8sCertificatesSchema(),
 			"cloud_config_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -241,6 +246,9 @@ func resourceClusterVirtual() *schema.Resource {
 				Default:     false,
 				Description: "If `true`, the cluster will be created asynchronously. Default value is `false`.",
 			},
+			"wait_for":       schemas.ClusterWaitForSchema(),
+			"wait_on_update": schemas.ClusterWaitOnUpdateSchema(),
+			"wait_settings":  schemas.WaitSettingsSchema(),
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -283,10 +291,15 @@ func resourceClusterVirtualStateUpgradeV2(ctx context.Context, rawState map[stri
 
 func resourceClusterVirtualCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	cluster, err := toVirtualCluster(c, d)
 	if err != nil {
@@ -298,7 +311,7 @@ func resourceClusterVirtualCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true)
+	diagnostics, isError := waitForClusterCreation(ctx, d, uid, diags, c, true, m)
 	if len(diagnostics) > 0 {
 		diags = append(diags, diagnostics...)
 	}
@@ -312,8 +325,12 @@ func resourceClusterVirtualCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 //goland:noinspection GoUnhandledErrorResult
-func resourceClusterVirtualRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceClusterVirtualRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	var diags diag.Diagnostics
@@ -327,7 +344,7 @@ func resourceClusterVirtualRead(_ context.Context, d *schema.ResourceData, m int
 		return diags
 	}
 
-	diagnostics, done := readCommonFields(c, d, cluster)
+	diagnostics, done := readCommonFields(c, d, cluster, m)
 	if done {
 		return diagnostics
 	}
@@ -414,10 +431,15 @@ func flattenCloudConfigVirtual(configUID string, d *schema.ResourceData, c *clie
 
 func resourceClusterVirtualUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resourceContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, resourceContext)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
+	diags = append(diags, clusterProfileVariablesWarnings(ctx, d, m)...)
 
 	cloudConfigId := d.Get("cloud_config_id").(string)
 	if d.HasChange("machine_pool") {
@@ -475,7 +497,7 @@ func resourceClusterVirtualUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	diagnostics, done := updateCommonFields(d, c)
+	diagnostics, done := updateCommonFields(ctx, d, c, m)
 	if done {
 		return diagnostics
 	}
@@ -511,17 +533,22 @@ func resourceClusterVirtualUpdate(ctx context.Context, d *schema.ResourceData, m
 			return diag.FromErr(err)
 		}
 		if *pause {
-			diagnostics, isError := waitForVirtualClusterLifecyclePause(ctx, d, d.Id(), diags, c)
+			diagnostics, isError := waitForVirtualClusterLifecyclePause(ctx, d, d.Id(), diags, c, m)
 			if isError {
 				return diagnostics
 			}
 		} else {
-			diagnostics, isError := waitForVirtualClusterLifecycleResume(ctx, d, d.Id(), diags, c)
+			diagnostics, isError := waitForVirtualClusterLifecycleResume(ctx, d, d.Id(), diags, c, m)
 			if isError {
 				return diagnostics
 			}
 		}
 	}
+
+	if waitDiags := waitForClusterUpdate(ctx, d, c, m); waitDiags.HasError() {
+		return waitDiags
+	}
+
 	resourceClusterVirtualRead(ctx, d, m)
 	return diags
 }
@@ -78,13 +78,13 @@ func toDeveloperSetting(d *schema.ResourceData) (*models.V1DeveloperCredit, *mod
 	if cpuInt > constants.Int32MaxValue || memoryInt > constants.Int32MaxValue || storageInt > constants.Int32MaxValue || virtualClustersLimitInt > constants.Int32MaxValue {
 		// Return default values if any value is out of range
 		return &models.V1DeveloperCredit{
-				CPU:                  12,
-				MemoryGiB:            16,
-				StorageGiB:           20,
-				VirtualClustersLimit: 2,
-			}, &models.V1TenantEnableClusterGroup{
-				HideSystemClusterGroups: false,
-			}
+			CPU:                  12,
+			MemoryGiB:            16,
+			StorageGiB:           20,
+			VirtualClustersLimit: 2,
+		}, &models.V1TenantEnableClusterGroup{
+			HideSystemClusterGroups: false,
+		}
 	}
 
 	devCredit := &models.V1DeveloperCredit{
@@ -101,13 +101,13 @@ func toDeveloperSetting(d *schema.ResourceData) (*models.V1DeveloperCredit, *mod
 
 func toDeveloperSettingDefault(d *schema.ResourceData) (*models.V1DeveloperCredit, *models.V1TenantEnableClusterGroup) {
 	return &models.V1DeveloperCredit{
-			CPU:                  12,
-			MemoryGiB:            16,
-			StorageGiB:           20,
-			VirtualClustersLimit: 2,
-		}, &models.V1TenantEnableClusterGroup{
-			HideSystemClusterGroups: false,
-		}
+		CPU:                  12,
+		MemoryGiB:            16,
+		StorageGiB:           20,
+		VirtualClustersLimit: 2,
+	}, &models.V1TenantEnableClusterGroup{
+		HideSystemClusterGroups: false,
+	}
 }
 
 func flattenDeveloperSetting(devSetting *models.V1DeveloperCredit, sysClusterGroupPref *models.V1TenantEnableClusterGroup, d *schema.ResourceData) error {
@@ -97,7 +97,7 @@ func resourceKubevirtVirtualMachineCreate(ctx context.Context, d *schema.Resourc
 		d.SetId(utils.BuildId(ClusterContext, clusterUid, vm.Metadata))
 	}
 	if d.Get("run_on_launch").(bool) {
-		diags, _ = waitForVirtualMachineToTargetState(ctx, d, cluster.Metadata.UID, virtualMachineHapi.Metadata.Name, virtualMachineHapi.Metadata.Namespace, diags, c, "create", "Running")
+		diags, _ = waitForVirtualMachineToTargetState(ctx, d, cluster.Metadata.UID, virtualMachineHapi.Metadata.Name, virtualMachineHapi.Metadata.Namespace, diags, c, "create", "Running", m)
 		if diags.HasError() {
 			return diags
 		}
@@ -189,13 +189,13 @@ func resourceVirtualMachineUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	if _, ok := d.GetOk("vm_action"); ok && d.HasChange("vm_action") {
 		stateToChange := d.Get("vm_action").(string)
-		resourceVirtualMachineActions(c, ctx, d, stateToChange, clusterUid, vmName, vmNamespace)
+		resourceVirtualMachineActions(c, ctx, d, stateToChange, clusterUid, vmName, vmNamespace, m)
 	}
 
 	return resourceKubevirtVirtualMachineRead(ctx, d, m)
 }
 
-func resourceVirtualMachineActions(c *client.V1Client, ctx context.Context, d *schema.ResourceData, stateToChange, clusterUid, vmName, vmNamespace string) diag.Diagnostics {
+func resourceVirtualMachineActions(c *client.V1Client, ctx context.Context, d *schema.ResourceData, stateToChange, clusterUid, vmName, vmNamespace string, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	//ClusterContext := d.Get("cluster_context").(string)
 	// need to add validation status and allowed actions
@@ -209,7 +209,7 @@ func resourceVirtualMachineActions(c *client.V1Client, ctx context.Context, d *s
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, vmName, vmNamespace, diags, c, "update", "Running")
+		diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, vmName, vmNamespace, diags, c, "update", "Running", m)
 		if diags.HasError() {
 			return diags
 		}
@@ -218,7 +218,7 @@ func resourceVirtualMachineActions(c *client.V1Client, ctx context.Context, d *s
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, vmName, vmNamespace, diags, c, "update", "Stopped")
+		diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, vmName, vmNamespace, diags, c, "update", "Stopped", m)
 		if diags.HasError() {
 			return diags
 		}
@@ -227,7 +227,7 @@ func resourceVirtualMachineActions(c *client.V1Client, ctx context.Context, d *s
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, vmName, vmNamespace, diags, c, "update", "Running")
+		diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, vmName, vmNamespace, diags, c, "update", "Running", m)
 		if diags.HasError() {
 			return diags
 		}
@@ -236,7 +236,7 @@ func resourceVirtualMachineActions(c *client.V1Client, ctx context.Context, d *s
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, vmName, vmNamespace, diags, c, "update", "Paused")
+		diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, vmName, vmNamespace, diags, c, "update", "Paused", m)
 		if diags.HasError() {
 			return diags
 		}
@@ -245,13 +245,13 @@ func resourceVirtualMachineActions(c *client.V1Client, ctx context.Context, d *s
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, vmName, vmNamespace, diags, c, "update", "Running")
+		diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, vmName, vmNamespace, diags, c, "update", "Running", m)
 		if diags.HasError() {
 			return diags
 		}
 	case "migrate":
 		_ = c.MigrateVirtualMachineNodeToNode(clusterUid, vmName, vmNamespace)
-		diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, vmName, vmNamespace, diags, c, "update", "Running")
+		diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, vmName, vmNamespace, diags, c, "update", "Running", m)
 		if diags.HasError() {
 			return diags
 		}
@@ -280,7 +280,7 @@ func resourceKubevirtVirtualMachineDelete(ctx context.Context, d *schema.Resourc
 	if err := c.DeleteVirtualMachine(clusterUid, namespace, name); err != nil {
 		return diag.FromErr(err)
 	}
-	diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, name, namespace, diags, c, "delete", "Deleted")
+	diags, _ = waitForVirtualMachineToTargetState(ctx, d, clusterUid, name, namespace, diags, c, "delete", "Deleted", m)
 	if diags.HasError() {
 		return diags
 	}
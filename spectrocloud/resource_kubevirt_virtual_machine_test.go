@@ -906,7 +906,7 @@ func TestResourceVirtualMachineActions(t *testing.T) {
 			c := tt.setupClient()
 			d := tt.setupData()
 
-			diags := resourceVirtualMachineActions(c, ctx, d, tt.stateToChange, clusterUID, vmName, vmNamespace)
+			diags := resourceVirtualMachineActions(c, ctx, d, tt.stateToChange, clusterUID, vmName, vmNamespace, nil)
 
 			if tt.verify != nil {
 				tt.verify(t, diags)
@@ -975,7 +975,7 @@ func TestResourceVirtualMachineActions_ActionCases(t *testing.T) {
 
 			// Verify function can be called without panicking
 			// Actual behavior depends on mock API and waitForVirtualMachineToTargetState
-			diags := resourceVirtualMachineActions(c, ctx, d, ac.stateToChange, clusterUID, vmName, vmNamespace)
+			diags := resourceVirtualMachineActions(c, ctx, d, ac.stateToChange, clusterUID, vmName, vmNamespace, nil)
 
 			// Note: Due to waitForVirtualMachineToTargetState polling behavior,
 			// these tests may timeout. The function structure is tested, but
@@ -1010,7 +1010,7 @@ func TestResourceVirtualMachineActions_CaseInsensitive(t *testing.T) {
 			d := resourceKubevirtVirtualMachine().TestResourceData()
 
 			// Function uses strings.ToLower, so all cases should match
-			diags := resourceVirtualMachineActions(c, ctx, d, tc.stateToChange, clusterUID, vmName, vmNamespace)
+			diags := resourceVirtualMachineActions(c, ctx, d, tc.stateToChange, clusterUID, vmName, vmNamespace, nil)
 
 			// Verify function executes (doesn't panic)
 			// Actual success depends on mock API and polling behavior
@@ -13,6 +13,8 @@ import (
 	"github.com/spectrocloud/palette-sdk-go/api/apiutil/transport"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
 	"github.com/spectrocloud/palette-sdk-go/client"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func resourceMacros() *schema.Resource {
@@ -49,18 +51,22 @@ func resourceMacros() *schema.Resource {
 				Description: "The context of the cluster profile. Allowed values are `project` or `tenant`. " +
 					"Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 		},
 	}
 }
 
 func resourceMacrosCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	macrosContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, "")
 	var diags diag.Diagnostics
 	contextUid := ""
-	var err error
 	if macrosContext == "project" {
-		contextUid = ProviderInitProjectUid
+		contextUid = getProviderProjectUID(m)
 	}
 	macroUID, err := c.CreateMacros(contextUid, toMacros(d))
 	if err != nil {
@@ -72,13 +78,16 @@ func resourceMacrosCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 func resourceMacrosRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	macrosContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, "")
 	var diags diag.Diagnostics
 	var macros []*models.V1Macro
-	var err error
 	contextUid := ""
 	if macrosContext == "project" {
-		contextUid = ProviderInitProjectUid
+		contextUid = getProviderProjectUID(m)
 	}
 	macros, err = c.GetTFMacrosV2(d.Get("macros").(map[string]interface{}), contextUid)
 	if err != nil {
@@ -109,12 +118,15 @@ func resourceMacrosRead(ctx context.Context, d *schema.ResourceData, m interface
 
 func resourceMacrosUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	macrosContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, "")
 	var diags diag.Diagnostics
-	var err error
 	contextUid := ""
 	if macrosContext == "project" {
-		contextUid = ProviderInitProjectUid
+		contextUid = getProviderProjectUID(m)
 	}
 	if d.HasChange("macros") {
 		oldMacros, _ := d.GetChange("macros")
@@ -138,12 +150,15 @@ func resourceMacrosUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 
 func resourceMacrosDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	macrosContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, "")
 	var diags diag.Diagnostics
-	var err error
 	contextUid := ""
 	if macrosContext == "project" {
-		contextUid = ProviderInitProjectUid
+		contextUid = getProviderProjectUID(m)
 	}
 	err = c.DeleteMacros(contextUid, toMacros(d))
 	if err != nil {
@@ -238,10 +253,14 @@ func resourceMacrosImport(ctx context.Context, d *schema.ResourceData, m interfa
 	var macros []*models.V1Macro
 
 	if macrosContext == "project" {
-		if contextID != ProviderInitProjectUid {
-			return nil, fmt.Errorf("invalid import: given project UID {%s} and provider project UID {%s} are different — cross-project resource imports are not allowed; project UID must match the provider configuration", contextID, ProviderInitProjectUid)
+		// Macros of a project other than the provider's are imported with
+		// the project argument set to it.
+		if contextID != getProviderProjectUID(m) {
+			if err := d.Set("project", contextID); err != nil {
+				return nil, err
+			}
 		}
-		macros, err = c.GetMacros(ProviderInitProjectUid)
+		macros, err = c.GetMacros(contextID)
 		if err != nil {
 			return nil, err
 		}
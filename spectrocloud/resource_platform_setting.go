@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
 	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/constants"
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func resourcePlatformSetting() *schema.Resource {
@@ -39,6 +40,7 @@ func resourcePlatformSetting() *schema.Resource {
 				Description: "Defines the scope of the platform setting. Valid values are `project` or `tenant`. " +
 					"By default, it is set to `tenant`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 			"session_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -126,8 +128,12 @@ func validateContextDependencies(ctx context.Context, d *schema.ResourceDiff, me
 	return nil
 }
 
-func updatePlatformSettings(d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func updatePlatformSettings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	platformSettingContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, platformSettingContext)
 	tenantUID, err := c.GetTenantUID()
 	if err != nil {
@@ -229,11 +235,11 @@ func updatePlatformSettings(d *schema.ResourceData, m interface{}) diag.Diagnost
 		d.SetId(fmt.Sprintf("platformsetting-%s", tenantUID))
 	} else {
 		// cluster node remediation for project
-		err = c.UpdateClusterAutoRemediationForProject(ProviderInitProjectUid, remediationSettings)
+		err = c.UpdateClusterAutoRemediationForProject(getProviderProjectUID(m), remediationSettings)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		d.SetId(fmt.Sprintf("platformsetting-%s", ProviderInitProjectUid))
+		d.SetId(fmt.Sprintf("platformsetting-%s", getProviderProjectUID(m)))
 	}
 	// pause agent upgrade setting according to context
 	err = c.UpdatePlatformClusterUpgradeSetting(&models.V1ClusterUpgradeSettingsEntity{
@@ -257,12 +263,16 @@ func convertFIPSString(flag string) bool {
 }
 
 func resourcePlatformSettingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	diags := updatePlatformSettings(d, m)
+	diags := updatePlatformSettings(ctx, d, m)
 	return diags
 }
 
 func resourcePlatformSettingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	platformSettingContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, platformSettingContext)
 	var diags diag.Diagnostics
 	tenantUID, err := c.GetTenantUID()
@@ -361,7 +371,7 @@ func resourcePlatformSettingRead(ctx context.Context, d *schema.ResourceData, m
 	} else {
 		// get cluster_auto_remediation project
 		var respProjectRemediation *models.V1ProjectClusterSettings
-		respProjectRemediation, err = c.GetClusterAutoRemediationForProject(ProviderInitProjectUid)
+		respProjectRemediation, err = c.GetClusterAutoRemediationForProject(getProviderProjectUID(m))
 		if err != nil {
 			return handleReadError(d, err, diags)
 		}
@@ -386,6 +396,10 @@ func resourcePlatformSettingRead(ctx context.Context, d *schema.ResourceData, m
 
 func resourcePlatformSettingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	platformSettingContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, platformSettingContext)
 	tenantUID, err := c.GetTenantUID()
 	if err != nil {
@@ -488,7 +502,7 @@ func resourcePlatformSettingUpdate(ctx context.Context, d *schema.ResourceData,
 	} else {
 		// cluster node remediation for project
 		if d.HasChanges("cluster_auto_remediation", "enable_auto_remediation") {
-			err = c.UpdateClusterAutoRemediationForProject(ProviderInitProjectUid, remediationSettings)
+			err = c.UpdateClusterAutoRemediationForProject(getProviderProjectUID(m), remediationSettings)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -506,8 +520,12 @@ func resourcePlatformSettingUpdate(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func updatePlatformSettingsDefault(d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func updatePlatformSettingsDefault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	platformSettingContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, platformSettingContext)
 	tenantUID, err := c.GetTenantUID()
 	if err != nil {
@@ -567,7 +585,7 @@ func updatePlatformSettingsDefault(d *schema.ResourceData, m interface{}) diag.D
 		}
 	} else {
 		// cluster node remediation for project
-		err = c.UpdateClusterAutoRemediationForProject(ProviderInitProjectUid, remediationSettings)
+		err = c.UpdateClusterAutoRemediationForProject(getProviderProjectUID(m), remediationSettings)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -583,7 +601,7 @@ func updatePlatformSettingsDefault(d *schema.ResourceData, m interface{}) diag.D
 }
 
 func resourcePlatformSettingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return updatePlatformSettingsDefault(d, m)
+	return updatePlatformSettingsDefault(ctx, d, m)
 }
 
 func resourcePlatformSettingImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
@@ -620,13 +638,13 @@ func resourcePlatformSettingImport(ctx context.Context, d *schema.ResourceData,
 		}
 		d.SetId(fmt.Sprintf("platformsetting-%s", actualTenantId))
 	} else {
-		if resolvedID != ProviderInitProjectUid {
-			return nil, fmt.Errorf("invalid import: given project %q and provider project UID %q are different — project must match the provider configuration", uid, ProviderInitProjectUid)
+		if resolvedID != getProviderProjectUID(m) {
+			return nil, fmt.Errorf("invalid import: given project %q and provider project UID %q are different — project must match the provider configuration", uid, getProviderProjectUID(m))
 		}
 		if err = d.Set("context", "project"); err != nil {
 			return nil, err
 		}
-		d.SetId(fmt.Sprintf("platformsetting-%s", ProviderInitProjectUid))
+		d.SetId(fmt.Sprintf("platformsetting-%s", getProviderProjectUID(m)))
 	}
 
 	diags := resourcePlatformSettingRead(ctx, d, m)
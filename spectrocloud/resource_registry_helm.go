@@ -109,7 +109,7 @@ func resourceRegistryHelmCreate(ctx context.Context, d *schema.ResourceData, m i
 
 	// Wait for sync if requested
 	if d.Get("wait_for_sync") != nil && d.Get("wait_for_sync").(bool) {
-		diagnostics, isError := waitForRegistrySync(ctx, d, uid, diags, c, schema.TimeoutCreate)
+		diagnostics, isError := waitForRegistrySync(ctx, d, uid, diags, c, schema.TimeoutCreate, m)
 		if len(diagnostics) > 0 {
 			diags = append(diags, diagnostics...)
 		}
@@ -197,7 +197,7 @@ func resourceRegistryHelmUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 	// Wait for sync if requested
 	if d.Get("wait_for_sync") != nil && d.Get("wait_for_sync").(bool) {
-		diagnostics, isError := waitForRegistrySync(ctx, d, d.Id(), diags, c, schema.TimeoutUpdate)
+		diagnostics, isError := waitForRegistrySync(ctx, d, d.Id(), diags, c, schema.TimeoutUpdate, m)
 		if len(diagnostics) > 0 {
 			diags = append(diags, diagnostics...)
 		}
@@ -273,7 +273,7 @@ func toRegistryHelmCredential(regCred map[string]interface{}) *models.V1Registry
 }
 
 // waitForRegistrySync waits for a Helm registry to complete its synchronization
-func waitForRegistrySync(ctx context.Context, d *schema.ResourceData, uid string, diags diag.Diagnostics, c *client.V1Client, timeoutType string) (diag.Diagnostics, bool) {
+func waitForRegistrySync(ctx context.Context, d *schema.ResourceData, uid string, diags diag.Diagnostics, c *client.V1Client, timeoutType string, m interface{}) (diag.Diagnostics, bool) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
 			"InProgress",
@@ -285,11 +285,10 @@ func waitForRegistrySync(ctx context.Context, d *schema.ResourceData, uid string
 			"Success",
 			"Completed",
 		},
-		Refresh:    resourceRegistrySyncRefreshFunc(c, uid),
-		Timeout:    d.Timeout(timeoutType) - 1*time.Minute,
-		MinTimeout: 10 * time.Second,
-		Delay:      resolveWaitDelay(30 * time.Second),
+		Refresh: resourceRegistrySyncRefreshFunc(c, uid),
+		Timeout: d.Timeout(timeoutType) - 1*time.Minute,
 	}
+	waitSettingsFor(m, d).apply(stateConf)
 
 	// Wait, catching any errors
 	_, err := stateConf.WaitForStateContext(ctx)
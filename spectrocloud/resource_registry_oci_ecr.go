@@ -290,7 +290,7 @@ func resourceRegistryEcrCreate(ctx context.Context, d *schema.ResourceData, m in
 		d.SetId(uid)
 		// Wait for sync if requested and provider_type is zarf or helm
 		if (providerType == "zarf" || providerType == "helm") && d.Get("wait_for_sync") != nil && d.Get("wait_for_sync").(bool) {
-			diagnostics, isError := waitForOciRegistrySync(ctx, d, uid, diags, c, schema.TimeoutCreate, "ecr")
+			diagnostics, isError := waitForOciRegistrySync(ctx, d, uid, diags, c, schema.TimeoutCreate, "ecr", m)
 			if len(diagnostics) > 0 {
 				diags = append(diags, diagnostics...)
 			}
@@ -323,7 +323,7 @@ func resourceRegistryEcrCreate(ctx context.Context, d *schema.ResourceData, m in
 		d.SetId(uid)
 		// Wait for sync if requested and provider_type is zarf or helm
 		if (providerType == "zarf" || providerType == "helm") && d.Get("wait_for_sync") != nil && d.Get("wait_for_sync").(bool) {
-			diagnostics, isError := waitForOciRegistrySync(ctx, d, uid, diags, c, schema.TimeoutCreate, "basic")
+			diagnostics, isError := waitForOciRegistrySync(ctx, d, uid, diags, c, schema.TimeoutCreate, "basic", m)
 			if len(diagnostics) > 0 {
 				diags = append(diags, diagnostics...)
 			}
@@ -548,7 +548,7 @@ func resourceRegistryEcrUpdate(ctx context.Context, d *schema.ResourceData, m in
 		}
 		// Wait for sync if requested and provider_type is zarf or helm
 		if (providerType == "zarf" || providerType == "helm") && d.Get("wait_for_sync") != nil && d.Get("wait_for_sync").(bool) {
-			diagnostics, isError := waitForOciRegistrySync(ctx, d, d.Id(), diags, c, schema.TimeoutUpdate, "ecr")
+			diagnostics, isError := waitForOciRegistrySync(ctx, d, d.Id(), diags, c, schema.TimeoutUpdate, "ecr", m)
 			if len(diagnostics) > 0 {
 				diags = append(diags, diagnostics...)
 			}
@@ -582,7 +582,7 @@ func resourceRegistryEcrUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 		// Wait for sync if requested and provider_type is zarf or helm
 		if (providerType == "zarf" || providerType == "helm") && d.Get("wait_for_sync") != nil && d.Get("wait_for_sync").(bool) {
-			diagnostics, isError := waitForOciRegistrySync(ctx, d, d.Id(), diags, c, schema.TimeoutUpdate, "basic")
+			diagnostics, isError := waitForOciRegistrySync(ctx, d, d.Id(), diags, c, schema.TimeoutUpdate, "basic", m)
 			if len(diagnostics) > 0 {
 				diags = append(diags, diagnostics...)
 			}
@@ -741,7 +741,7 @@ func getOciRegistrySyncStatus(c *client.V1Client, uid, registryType string) (*mo
 }
 
 // waitForOciRegistrySync waits for an OCI registry to complete its synchronization
-func waitForOciRegistrySync(ctx context.Context, d *schema.ResourceData, uid string, diags diag.Diagnostics, c *client.V1Client, timeoutType, registryType string) (diag.Diagnostics, bool) {
+func waitForOciRegistrySync(ctx context.Context, d *schema.ResourceData, uid string, diags diag.Diagnostics, c *client.V1Client, timeoutType, registryType string, m interface{}) (diag.Diagnostics, bool) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
 			"InProgress",
@@ -753,11 +753,10 @@ func waitForOciRegistrySync(ctx context.Context, d *schema.ResourceData, uid str
 			"Success",
 			"Completed",
 		},
-		Refresh:    resourceOciRegistrySyncRefreshFunc(c, uid, registryType),
-		Timeout:    d.Timeout(timeoutType) - 1*time.Minute,
-		MinTimeout: 10 * time.Second,
-		Delay:      resolveWaitDelay(30 * time.Second),
+		Refresh: resourceOciRegistrySyncRefreshFunc(c, uid, registryType),
+		Timeout: d.Timeout(timeoutType) - 1*time.Minute,
 	}
+	waitSettingsFor(m, d).apply(stateConf)
 
 	// Wait, catching any errors
 	_, err := stateConf.WaitForStateContext(ctx)
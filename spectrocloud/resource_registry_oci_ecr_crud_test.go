@@ -627,7 +627,7 @@ func TestWaitForOciRegistrySync_Success(t *testing.T) {
 	d := resourceRegistryOciEcr().TestResourceData()
 	c := castV1Client(t, unitTestMockAPIClient)
 
-	diags, isError := waitForOciRegistrySync(context.Background(), d, "test-oci-registry-uid", diag.Diagnostics{}, c, schema.TimeoutCreate, "basic")
+	diags, isError := waitForOciRegistrySync(context.Background(), d, "test-oci-registry-uid", diag.Diagnostics{}, c, schema.TimeoutCreate, "basic", nil)
 	assert.False(t, isError, "diags: %+v", diags)
 }
 
@@ -639,6 +639,6 @@ func TestWaitForOciRegistrySync_APIError(t *testing.T) {
 	d := resourceRegistryOciEcr().TestResourceData()
 	c := castV1Client(t, unitTestMockAPIClient)
 
-	diags, isError := waitForOciRegistrySync(context.Background(), d, "test-oci-registry-uid", diag.Diagnostics{}, c, schema.TimeoutCreate, "ecr")
+	diags, isError := waitForOciRegistrySync(context.Background(), d, "test-oci-registry-uid", diag.Diagnostics{}, c, schema.TimeoutCreate, "ecr", nil)
 	assert.True(t, isError, "diags: %+v", diags)
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
 )
 
 func resourceSSHKey() *schema.Resource {
@@ -47,6 +49,7 @@ func resourceSSHKey() *schema.Resource {
 				Description: "The context of the cluster profile. Allowed values are `project` or `tenant`. " +
 					"Default value is `project`. " + PROJECT_NAME_NUANCE,
 			},
+			"project": schemas.ProjectSchema(),
 		},
 	}
 }
@@ -76,6 +79,10 @@ func flattenSSHKey(sshKey *models.V1UserAssetSSH, d *schema.ResourceData) error
 
 func resourceSSHKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sshKeyContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, sshKeyContext)
 	var diags diag.Diagnostics
 	sshKey, err := toSSHKey(d)
@@ -92,6 +99,10 @@ func resourceSSHKeyCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 func resourceSSHKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sshKeyContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, sshKeyContext)
 	var diags diag.Diagnostics
 
@@ -112,6 +123,10 @@ func resourceSSHKeyRead(ctx context.Context, d *schema.ResourceData, m interface
 
 func resourceSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sshKeyContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, sshKeyContext)
 	var diags diag.Diagnostics
 	sshKey, err := toSSHKey(d)
@@ -127,10 +142,14 @@ func resourceSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 
 func resourceSSHKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sshKeyContext := d.Get("context").(string)
+	m, err := withResourceProject(ctx, m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	c := getV1ClientWithResourceContext(m, sshKeyContext)
 	var diags diag.Diagnostics
 
-	err := c.DeleteSSHKey(d.Id())
+	err = c.DeleteSSHKey(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
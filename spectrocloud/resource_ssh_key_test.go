@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/spectrocloud/palette-sdk-go/api/models"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/tests/mockApiServer/mockserver"
 )
 
 // prepareSSHKeyResourceData is the fixture for both positive and negative
@@ -95,3 +97,39 @@ func TestFlattenSSHKey(t *testing.T) {
 	assert.Equal(t, "flatten-name", d.Get("name"))
 	assert.Equal(t, "ssh-rsa flatten-key", d.Get("ssh_key"))
 }
+
+// TestResourceSSHKeyReadUnderFaults checks the SDK retry path the provider
+// relies on: a one-off 429 is retried transparently, while a 500 is not
+// and surfaces as a diagnostic. Faults are scoped to a UID no other test
+// reads, since the mock server is shared.
+func TestResourceSSHKeyReadUnderFaults(t *testing.T) {
+	const path = "/v1/users/assets/sshkeys/fault-ssh-key"
+	defer mockAPIServer.ClearFaults()
+
+	t.Run("429 is retried", func(t *testing.T) {
+		id, err := mockAPIServer.InjectFault(mockserver.Fault{Method: "GET", Path: path,
+			Kind: mockserver.FaultStatus, Status: 429, Count: 1})
+		require.NoError(t, err)
+		defer mockAPIServer.RemoveFault(id)
+
+		d := prepareSSHKeyResourceData()
+		d.SetId("fault-ssh-key")
+		diags := resourceSSHKeyRead(context.Background(), d, unitTestMockAPIClient)
+		assert.Empty(t, diags)
+		assert.Equal(t, "test-ssh-key", d.Get("name"))
+		assert.Equal(t, 1, mockAPIServer.Faults()[0].Hits)
+	})
+
+	t.Run("500 is not retried", func(t *testing.T) {
+		id, err := mockAPIServer.InjectFault(mockserver.Fault{Method: "GET", Path: path,
+			Kind: mockserver.FaultStatus, Status: 500})
+		require.NoError(t, err)
+		defer mockAPIServer.RemoveFault(id)
+
+		d := prepareSSHKeyResourceData()
+		d.SetId("fault-ssh-key")
+		diags := resourceSSHKeyRead(context.Background(), d, unitTestMockAPIClient)
+		assert.True(t, diags.HasError())
+		assert.Equal(t, 1, mockAPIServer.Faults()[0].Hits)
+	})
+}
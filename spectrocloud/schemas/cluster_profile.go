@@ -19,7 +19,7 @@ func ClusterProfileSchema() *schema.Schema {
 				"variables": {
 					Type:        schema.TypeMap,
 					Optional:    true,
-					Description: "A map of cluster profile variables, specified as key-value pairs. For example: `priority = \"5\"`.",
+					Description: "A map of cluster profile variables, specified as key-value pairs. For example: `priority = \"5\"`. Values are validated at plan time against the variables the profile defines, unless some of them are only known at apply time. Variables left to their default value are reported as warnings when the change is applied.",
 					Elem: &schema.Schema{
 						Type: schema.TypeString,
 					},
@@ -41,7 +41,7 @@ func ClusterProfileSchemaV2() *schema.Schema {
 			"variables": {
 				Type:        schema.TypeMap,
 				Optional:    true,
-				Description: "A map of cluster profile variables, specified as key-value pairs. For example: `priority = \"5\"`.",
+				Description: "A map of cluster profile variables, specified as key-value pairs. For example: `priority = \"5\"`. Values are validated at plan time against the variables the profile defines, unless some of them are only known at apply time. Variables left to their default value are reported as warnings when the change is applied.",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -0,0 +1,69 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ClusterWaitForSchema returns the schema for the wait_for block on cluster
+// resources, which sets what a cluster has to reach before Terraform
+// considers its creation complete.
+func ClusterWaitForSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The conditions the cluster has to meet before its creation is considered complete. Without this block, the provider waits for the cluster to be `Running-Healthy`. Has no effect when `skip_completion` is set.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"target": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "running_healthy",
+					ValidateFunc: validation.StringInSlice([]string{"running_healthy", "running", "control_plane_ready"}, false),
+					Description: "The cluster state to wait for. `running_healthy` waits for the cluster to be running and report healthy, " +
+						"`running` accepts a running cluster whatever its health, and `control_plane_ready` only waits until Palette has issued the cluster's kubeconfig, " +
+						"which happens once the control plane is up. Defaults to `running_healthy`.",
+				},
+				"machine_pools_ready": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Also wait until every machine pool has its desired number of healthy nodes, as reported in the cluster's overview.",
+				},
+				"packs_deployed": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Also wait until every pack in the cluster's profiles, including add-on packs, is deployed and ready.",
+				},
+				"healthy_packs": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Set:         schema.HashString,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Names of packs that must be deployed and ready before creation completes.",
+				},
+				"kubeconfig_reachable": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Also wait until the cluster's Kubernetes API server answers through the admin kubeconfig Palette issued for it.",
+				},
+			},
+		},
+	}
+}
+
+// ClusterWaitOnUpdateSchema returns the schema for the wait_on_update flag
+// on cluster resources.
+func ClusterWaitOnUpdateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "If `true`, updates wait until the cluster has finished upgrading or modifying and is `Running-Healthy` again, " +
+			"within the `update` timeout. A change that does not take the cluster out of `Running-Healthy` within two minutes, such as a tag change, is considered applied. " +
+			"Default value is `false`, which returns as soon as Palette accepts the change.",
+	}
+}
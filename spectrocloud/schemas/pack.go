@@ -54,13 +54,25 @@ func PackSchema() *schema.Schema {
 						"If `uid` is not provided, this field is required along with `name` and `registry_uid` (or `registry_name`) to resolve the pack UID internally.",
 				},
 				"values": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "The values of the pack. The values are the configuration values of the pack. The values are specified in YAML format. ",
-					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-						// UI strips the trailing newline on save
-						return strings.TrimSpace(old) == strings.TrimSpace(new)
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "The values of the pack. The values are the configuration values of the pack. The values are specified in YAML format. ",
+					DiffSuppressFunc: suppressPackValuesDiff,
+				},
+				"values_overrides": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: ValidatePackValuesOverride,
 					},
+					Description: "Changes to apply on top of the pack values, in order. Each entry is either a YAML fragment such as `image: {tag: \"1.25\"}` " +
+						"or a `path=value` pair such as `image.tag=1.25`, where the value is parsed as YAML and a dot within a key is escaped as `\\.`. " +
+						"Mappings are merged key by key, lists and other values are replaced, and `null` removes a key. " +
+						"The overrides apply to `values` when set; otherwise to the pack's default values from the registry, " +
+						"or, in a cluster's `cluster_profile` block, to the values the cluster profile sets for the pack. " +
+						"Default values are only read when the change is applied, so without `values` the plan checks the overrides against the merged values in state: " +
+						"it does not show the merged document, nor changes to the defaults. Removing every override plans the merged values being replaced with `values`.",
 				},
 				"manifest": {
 					Type:     schema.TypeList,
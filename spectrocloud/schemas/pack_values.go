@@ -0,0 +1,200 @@
+package schemas
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// packValuesOverridePath matches a single-line `path=value` override. The
+// path is everything up to the first "=" and may not contain whitespace or
+// YAML mapping syntax, which tells it apart from a YAML fragment.
+var packValuesOverridePath = regexp.MustCompile(`^([^\s:=#{}\[\],]+)=(.*)$`)
+
+// PackValuesOverrides returns the values_overrides of a pack block.
+func PackValuesOverrides(p map[string]interface{}) []string {
+	raw, ok := p["values_overrides"].([]interface{})
+	if !ok {
+		return nil
+	}
+	overrides := make([]string, 0, len(raw))
+	for _, o := range raw {
+		if s, ok := o.(string); ok && strings.TrimSpace(s) != "" {
+			overrides = append(overrides, s)
+		}
+	}
+	return overrides
+}
+
+// ValidatePackValuesOverride validates a single values_overrides entry.
+func ValidatePackValuesOverride(v interface{}, k string) ([]string, []error) {
+	if _, err := parsePackValuesOverride(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q: %w", k, err)}
+	}
+	return nil, nil
+}
+
+// MergePackValues applies overrides, in order, on top of the pack values in
+// base. Mappings are merged key by key, any other value (including lists)
+// replaces the one in base, and a null value removes the key. Comments and
+// key order of base are kept.
+func MergePackValues(base string, overrides []string) (string, error) {
+	root := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	if strings.TrimSpace(base) != "" {
+		var doc yaml.Node
+		if err := yaml.Unmarshal([]byte(base), &doc); err != nil {
+			return "", fmt.Errorf("parsing pack values: %w", err)
+		}
+		if len(doc.Content) > 0 {
+			root = doc.Content[0]
+		}
+		if root.Kind != yaml.MappingNode {
+			return "", fmt.Errorf("pack values must be a YAML mapping to apply values_overrides")
+		}
+	}
+
+	for i, o := range overrides {
+		override, err := parsePackValuesOverride(o)
+		if err != nil {
+			return "", fmt.Errorf("values_overrides[%d]: %w", i, err)
+		}
+		mergeYAMLMapping(root, override)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if len(root.Content) > 0 {
+		if err := enc.Encode(root); err != nil {
+			return "", err
+		}
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// PackValuesMatchOverrides reports whether the pack values current already
+// reflect overrides applied to base. An empty base stands for values the
+// provider can't see at plan time (registry or profile defaults), so the
+// overrides are checked against current itself.
+func PackValuesMatchOverrides(current, base string, overrides []string) bool {
+	if strings.TrimSpace(base) == "" {
+		base = current
+	}
+	merged, err := MergePackValues(base, overrides)
+	if err != nil {
+		return false
+	}
+	return YamlContentHash(current) == YamlContentHash(merged)
+}
+
+// suppressPackValuesDiff is the DiffSuppressFunc of the pack values. When the
+// pack has values_overrides, state holds the merged values Palette returns,
+// so they are compared against the configured values with the overrides
+// applied.
+func suppressPackValuesDiff(k, old, new string, d *schema.ResourceData) bool {
+	// UI strips the trailing newline on save
+	if strings.TrimSpace(old) == strings.TrimSpace(new) {
+		return true
+	}
+	raw, ok := d.Get(strings.TrimSuffix(k, "values") + "values_overrides").([]interface{})
+	if !ok {
+		return false
+	}
+	overrides := PackValuesOverrides(map[string]interface{}{"values_overrides": raw})
+	return len(overrides) > 0 && PackValuesMatchOverrides(old, new, overrides)
+}
+
+// parsePackValuesOverride turns a values_overrides entry into the mapping
+// node to merge: either a YAML mapping fragment or a `path=value` pair.
+func parsePackValuesOverride(s string) (*yaml.Node, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("override must not be empty")
+	}
+
+	if m := packValuesOverridePath.FindStringSubmatch(s); m != nil {
+		value := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str"}
+		if m[2] != "" {
+			var doc yaml.Node
+			if err := yaml.Unmarshal([]byte(m[2]), &doc); err != nil {
+				return nil, fmt.Errorf("invalid value for %s: %w", m[1], err)
+			}
+			if len(doc.Content) > 0 {
+				value = doc.Content[0]
+			}
+		}
+		path := splitPackValuesPath(m[1])
+		for i := len(path) - 1; i >= 0; i-- {
+			if path[i] == "" {
+				return nil, fmt.Errorf("invalid path %q: empty key", m[1])
+			}
+			value = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: []*yaml.Node{
+				{Kind: yaml.ScalarNode, Tag: "!!str", Value: path[i]},
+				value,
+			}}
+		}
+		return value, nil
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, fmt.Errorf("override must be a YAML mapping or a path=value pair: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("override must be a YAML mapping or a path=value pair, got %q", s)
+	}
+	return doc.Content[0], nil
+}
+
+// splitPackValuesPath splits a dotted path. A dot that is part of a key is
+// escaped as `\.`, e.g. `podAnnotations.prometheus\.io/scrape`.
+func splitPackValuesPath(path string) []string {
+	var keys []string
+	var key strings.Builder
+	for i := 0; i < len(path); i++ {
+		switch {
+		case path[i] == '\\' && i+1 < len(path) && path[i+1] == '.':
+			key.WriteByte('.')
+			i++
+		case path[i] == '.':
+			keys = append(keys, key.String())
+			key.Reset()
+		default:
+			key.WriteByte(path[i])
+		}
+	}
+	return append(keys, key.String())
+}
+
+func mergeYAMLMapping(dst, src *yaml.Node) {
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, value := src.Content[i], src.Content[i+1]
+		idx := -1
+		for j := 0; j+1 < len(dst.Content); j += 2 {
+			if dst.Content[j].Value == key.Value {
+				idx = j
+				break
+			}
+		}
+
+		switch {
+		case value.Kind == yaml.ScalarNode && value.Tag == "!!null":
+			if idx >= 0 {
+				dst.Content = append(dst.Content[:idx], dst.Content[idx+2:]...)
+			}
+		case idx < 0:
+			dst.Content = append(dst.Content, key, value)
+		case dst.Content[idx+1].Kind == yaml.MappingNode && value.Kind == yaml.MappingNode:
+			mergeYAMLMapping(dst.Content[idx+1], value)
+		default:
+			dst.Content[idx+1] = value
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPackValues = `replicaCount: 1
+image:
+  repository: nginx
+  # pinned by the chart
+  tag: "1.25"
+tolerations:
+  - key: dedicated
+    operator: Exists`
+
+func TestMergePackValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      string
+		overrides []string
+		expected  string
+		err       string
+	}{
+		{
+			name:      "path=value keeps the rest of the document",
+			base:      testPackValues,
+			overrides: []string{"image.tag=1.26"},
+			expected:  "replicaCount: 1\nimage:\n  repository: nginx\n  # pinned by the chart\n  tag: 1.26\ntolerations:\n  - key: dedicated\n    operator: Exists",
+		},
+		{
+			name:      "fragment merges mappings and replaces lists",
+			base:      testPackValues,
+			overrides: []string{"image:\n  pullPolicy: Always\ntolerations: []"},
+			expected:  "replicaCount: 1\nimage:\n  repository: nginx\n  # pinned by the chart\n  tag: \"1.25\"\n  pullPolicy: Always\ntolerations: []",
+		},
+		{
+			name:      "later overrides win and null removes a key",
+			base:      testPackValues,
+			overrides: []string{"replicaCount=3", "replicaCount=5", "image: null", "tolerations: ~"},
+			expected:  "replicaCount: 5",
+		},
+		{
+			name:      "new nested keys and escaped dots",
+			base:      "",
+			overrides: []string{`podAnnotations.prometheus\.io/scrape="true"`, "resources.limits.cpu=500m", "name="},
+			expected:  "podAnnotations:\n  prometheus.io/scrape: \"true\"\nresources:\n  limits:\n    cpu: 500m\nname: \"\"",
+		},
+		{
+			name:      "a value replaces a mapping",
+			base:      testPackValues,
+			overrides: []string{"image=nginx:1.26", "tolerations=[]"},
+			expected:  "replicaCount: 1\nimage: nginx:1.26\ntolerations: []",
+		},
+		{
+			name:     "no overrides",
+			base:     "a: 1\n",
+			expected: "a: 1",
+		},
+		{
+			name:      "invalid override",
+			base:      testPackValues,
+			overrides: []string{"image.tag=1.26", "just a string"},
+			err:       `values_overrides[1]: override must be a YAML mapping or a path=value pair, got "just a string"`,
+		},
+		{
+			name:      "base that is not a mapping",
+			base:      "- a\n- b",
+			overrides: []string{"a=1"},
+			err:       "pack values must be a YAML mapping to apply values_overrides",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, err := MergePackValues(tt.base, tt.overrides)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, merged)
+		})
+	}
+}
+
+func TestValidatePackValuesOverride(t *testing.T) {
+	for _, valid := range []string{"image.tag=1.26", "a: 1", "image:\n  tag: \"1.26\"", "url=http://example.com/?a=b"} {
+		_, errs := ValidatePackValuesOverride(valid, "values_overrides.0")
+		assert.Empty(t, errs, valid)
+	}
+	for _, invalid := range []string{"", "   ", "plain", "- a", "a: [1"} {
+		_, errs := ValidatePackValuesOverride(invalid, "values_overrides.0")
+		assert.NotEmpty(t, errs, invalid)
+	}
+}
+
+func TestPackValuesMatchOverrides(t *testing.T) {
+	overrides := []string{"image.tag=1.26"}
+	merged, err := MergePackValues(testPackValues, overrides)
+	require.NoError(t, err)
+
+	// Palette may return the merged values reformatted.
+	current := "image: {repository: nginx, tag: 1.26}\nreplicaCount: 1\ntolerations: [{key: dedicated, operator: Exists}]"
+	assert.True(t, PackValuesMatchOverrides(merged, testPackValues, overrides))
+	assert.True(t, PackValuesMatchOverrides(current, testPackValues, overrides))
+	assert.True(t, PackValuesMatchOverrides(current, "", overrides), "defaults that already carry the overrides")
+	assert.False(t, PackValuesMatchOverrides(testPackValues, "", overrides), "overrides not applied yet")
+	assert.False(t, PackValuesMatchOverrides(current, testPackValues, []string{"image.tag=1.27"}))
+	assert.False(t, PackValuesMatchOverrides(current, testPackValues, []string{"not valid"}))
+}
+
+func TestSuppressPackValuesDiff(t *testing.T) {
+	r := &schema.Resource{Schema: map[string]*schema.Schema{"pack": PackSchema()}}
+	d := r.TestResourceData()
+	require.NoError(t, d.Set("pack", []interface{}{
+		map[string]interface{}{"name": "nginx", "values_overrides": []interface{}{"image.tag=1.26"}},
+		map[string]interface{}{"name": "k8"},
+	}))
+
+	merged, err := MergePackValues(testPackValues, []string{"image.tag=1.26"})
+	require.NoError(t, err)
+	assert.True(t, suppressPackValuesDiff("pack.0.values", merged, "", d))
+	assert.True(t, suppressPackValuesDiff("pack.0.values", merged, testPackValues, d))
+	assert.False(t, suppressPackValuesDiff("pack.0.values", testPackValues, "", d))
+	assert.True(t, suppressPackValuesDiff("pack.1.values", "a: 1\n", "a: 1", d))
+	assert.False(t, suppressPackValuesDiff("pack.1.values", merged, testPackValues, d), "pack without overrides")
+}
@@ -0,0 +1,30 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProjectSchema returns the schema for the per-resource project field. It
+// overrides the provider's project_name for one resource, so a single
+// provider block can manage resources across several projects. Changing it
+// moves the resource to another project, which means replacing it.
+func ProjectSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Description: "The name or UID of the project the resource belongs to, overriding the provider's `project_name`. " +
+			"Only valid with the `project` context. If not specified, the provider's `project_name` is used.",
+	}
+}
+
+// DataSourceProjectSchema is ProjectSchema for data sources, which look up
+// an object in the given project rather than own one.
+func DataSourceProjectSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "The name or UID of the project to look the object up in, overriding the provider's `project_name`. " +
+			"Only valid with the `project` context. If not specified, the provider's `project_name` is used.",
+	}
+}
@@ -14,7 +14,65 @@ func RenewK8sCertificatesNowSchema() *schema.Schema {
 			"NOTE: The renewal is initiated immediately when this value changes - the timestamp does NOT schedule a future renewal. " +
 			"Set this to the current timestamp each time you want to trigger certificate renewal. " +
 			"This field can also be used for tracking when renewals were triggered. " +
-			"Renewal may take several minutes depending on cluster size. Only control plane certificates are renewed; worker node certificates are not supported. " +
+			"Renewal may take several minutes depending on cluster size; the apply waits until the certificates are renewed and the cluster is healthy again. " +
+			"Only control plane certificates are renewed; worker node certificates are not supported. " +
 			"Format: RFC3339 (e.g., '2024-01-15T10:30:00Z').",
 	}
 }
+
+func AutoRenewCertificatesBeforeDaysSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntBetween(0, 364),
+		Description: "Plan a renewal of the control plane Kubernetes PKI certificates when the first of them expires in fewer than this many days, " +
+			"as reported by `k8s_certificates_expiry` at plan time. The renewal is applied like `renew_k8s_certificates_now`, and waited on. " +
+			"At most `364`, as renewed certificates are valid for a year. Default value is `0`, which never plans a renewal.",
+	}
+}
+
+func K8sCertificatesExpirySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time the first control plane Kubernetes PKI certificate of the cluster expires, in RFC3339 format. Empty when Palette reports no certificates.",
+	}
+}
+
+func K8sCertificatesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The control plane Kubernetes PKI certificates of the cluster, by machine.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"machine": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the control plane machine the certificate is on.",
+				},
+				"certificate_authority": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the certificate authority that issued the certificate, such as `ca` or `etcd-ca`.",
+				},
+				"certificate_authority_expiry": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The time the certificate authority expires, in RFC3339 format. Renewals do not renew certificate authorities.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the certificate, such as `apiserver` or `etcd-server`.",
+				},
+				"expiry": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The time the certificate expires, in RFC3339 format.",
+				},
+			},
+		},
+	}
+}
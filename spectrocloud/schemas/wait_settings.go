@@ -0,0 +1,78 @@
+package schemas
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// MaxWaitPollInterval is the longest interval a waiter can poll at. The
+// SDK's state waiter ignores poll intervals of three minutes or more.
+const MaxWaitPollInterval = 3*time.Minute - time.Second
+
+// WaitSettingsSchema returns the schema for the per-resource wait_settings
+// block. Each field overrides the matching provider-level wait_* argument
+// for this resource's waits; fields left unset inherit the provider's.
+func WaitSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Overrides the provider's `wait_*` settings for how this resource polls Palette while waiting for an operation to finish.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"initial_delay": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: ValidateWaitDelay,
+					Description:  "How long to wait before the first status check, e.g. `30s` or `5m`. Defaults to the provider's `wait_initial_delay`.",
+				},
+				"poll_interval": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: ValidateWaitPollInterval,
+					Description:  "How long to wait between status checks, e.g. `10s`. At most `2m59s`. Defaults to the provider's `wait_poll_interval`.",
+				},
+				"backoff_multiplier": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					ValidateFunc: validation.FloatAtLeast(1),
+					Description:  "Factor the poll interval grows by after each status check. `1` polls at a fixed interval. Defaults to the provider's `wait_backoff_multiplier`.",
+				},
+				"max_poll_interval": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: ValidateWaitPollInterval,
+					Description:  "Upper bound for the poll interval as it backs off. At most `2m59s`. Defaults to the provider's `wait_max_poll_interval`.",
+				},
+			},
+		},
+	}
+}
+
+// ValidateWaitDelay accepts a non-negative Go duration string.
+func ValidateWaitDelay(v interface{}, k string) ([]string, []error) {
+	d, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration such as \"30s\" or \"5m\": %w", k, err)}
+	}
+	if d < 0 {
+		return nil, []error{fmt.Errorf("%q must not be negative, got %s", k, d)}
+	}
+	return nil, nil
+}
+
+// ValidateWaitPollInterval accepts a Go duration string between one second
+// and MaxWaitPollInterval.
+func ValidateWaitPollInterval(v interface{}, k string) ([]string, []error) {
+	d, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration such as \"10s\" or \"1m\": %w", k, err)}
+	}
+	if d < time.Second || d > MaxWaitPollInterval {
+		return nil, []error{fmt.Errorf("%q must be between 1s and %s, got %s", k, MaxWaitPollInterval, d)}
+	}
+	return nil, nil
+}
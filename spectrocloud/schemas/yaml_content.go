@@ -0,0 +1,104 @@
+package schemas
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YamlContentHash creates a hash based on YAML semantic content, ignoring formatting
+func YamlContentHash(yamlContent string) string {
+	canonicalContent := yamlContentToCanonicalString(yamlContent)
+	h := fnv.New64a()
+	if _, err := h.Write([]byte(canonicalContent)); err != nil {
+		// If hash writing fails, return a fallback hash
+		return fmt.Sprintf("error_hash_%d", time.Now().UnixNano())
+	}
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
+// yamlContentToCanonicalString converts YAML content to a canonical string for hashing
+func yamlContentToCanonicalString(yamlContent string) string {
+	if strings.TrimSpace(yamlContent) == "" {
+		return ""
+	}
+
+	// Split multi-document YAML
+	documents := strings.Split(yamlContent, "---")
+	var canonicalDocs []string
+
+	for _, doc := range documents {
+		doc = strings.TrimSpace(doc)
+		if doc == "" {
+			continue
+		}
+
+		// Parse YAML document
+		var yamlData interface{}
+		if err := yaml.Unmarshal([]byte(doc), &yamlData); err != nil {
+			// If parsing fails, use original doc for canonical form
+			canonicalDocs = append(canonicalDocs, doc)
+			continue
+		}
+
+		// Convert to canonical string representation
+		canonical := toCanonicalString(yamlData)
+		canonicalDocs = append(canonicalDocs, canonical)
+	}
+
+	if len(canonicalDocs) == 0 {
+		return ""
+	}
+
+	return strings.Join(canonicalDocs, "|||") // Use ||| as document separator
+}
+
+// toCanonicalString converts a YAML structure to a deterministic string representation
+func toCanonicalString(data interface{}) string {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		// Sort keys for deterministic output
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var parts []string
+		for _, k := range keys {
+			value := toCanonicalString(v[k])
+			parts = append(parts, fmt.Sprintf("%s:%s", k, value))
+		}
+		return "{" + strings.Join(parts, ",") + "}"
+
+	case map[interface{}]interface{}:
+		// Convert to string map and recurse
+		stringMap := make(map[string]interface{})
+		for key, value := range v {
+			if keyStr, ok := key.(string); ok {
+				stringMap[keyStr] = value
+			}
+		}
+		return toCanonicalString(stringMap)
+
+	case []interface{}:
+		var parts []string
+		for _, item := range v {
+			parts = append(parts, toCanonicalString(item))
+		}
+		return "[" + strings.Join(parts, ",") + "]"
+
+	case string:
+		return fmt.Sprintf("\"%s\"", v)
+	case int, int64, float64, bool:
+		return fmt.Sprintf("%v", v)
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
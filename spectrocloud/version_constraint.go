@@ -0,0 +1,139 @@
+package spectrocloud
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// latestPatchOf is the version_constraint prefix that selects the newest
+// patch release of a minor version, e.g. "latest-patch-of 1.4".
+const latestPatchOf = "latest-patch-of"
+
+// versionConstraintSchema is the version_constraint argument of the profile
+// and pack data sources. what names the thing being versioned.
+func versionConstraintSchema(what string, conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: conflictsWith,
+		ValidateFunc:  validateVersionConstraint,
+		Description: fmt.Sprintf("A constraint the %s version must satisfy, such as `~> 1.28`, `>= 1.2.0, < 2.0.0` or `latest-patch-of 1.4`. "+
+			"The highest matching version is selected. `~>` follows Terraform's pessimistic constraint: `~> 1.28` allows any `1.x` from `1.28` on, "+
+			"while `~> 1.28.2` only allows `1.28.x` patches from `1.28.2` on. Conflicts with `version`.", what),
+	}
+}
+
+// matchingVersionsSchema is the matching_versions attribute exported next to
+// version_constraint.
+func matchingVersionsSchema(what string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: fmt.Sprintf("The %s versions that satisfy `version_constraint`, highest first. Empty when `version_constraint` is not set.", what),
+	}
+}
+
+func validateVersionConstraint(v interface{}, k string) ([]string, []error) {
+	if _, err := parseVersionConstraint(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q: %w", k, err)}
+	}
+	return nil, nil
+}
+
+// parseVersionConstraint parses a version_constraint. Besides the
+// Masterminds constraint syntax it accepts Terraform's "~>" operator and
+// "latest-patch-of X.Y".
+func parseVersionConstraint(s string) (*semver.Constraints, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("version constraint must not be empty")
+	}
+	if rest, ok := strings.CutPrefix(s, latestPatchOf); ok {
+		v, err := semver.NewVersion(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, fmt.Errorf("%s needs a version such as \"1.4\": %w", latestPatchOf, err)
+		}
+		return semver.NewConstraint(fmt.Sprintf("~%s", v))
+	}
+
+	var groups []string
+	for _, group := range strings.Split(s, "||") {
+		var terms []string
+		for _, term := range strings.Split(group, ",") {
+			term = strings.TrimSpace(term)
+			if rest, ok := strings.CutPrefix(term, "~>"); ok {
+				expanded, err := expandPessimisticConstraint(strings.TrimSpace(rest))
+				if err != nil {
+					return nil, err
+				}
+				term = expanded
+			}
+			terms = append(terms, term)
+		}
+		groups = append(groups, strings.Join(terms, ", "))
+	}
+	c, err := semver.NewConstraint(strings.Join(groups, " || "))
+	if err != nil {
+		return nil, fmt.Errorf("invalid version constraint %q: %w", s, err)
+	}
+	return c, nil
+}
+
+// expandPessimisticConstraint rewrites Terraform's "~> v" as a range: only
+// the rightmost component given in v may increase.
+func expandPessimisticConstraint(v string) (string, error) {
+	parts := strings.Split(strings.TrimPrefix(v, "v"), ".")
+	version, err := semver.NewVersion(v)
+	if err != nil {
+		return "", fmt.Errorf("invalid version %q after ~>: %w", v, err)
+	}
+	upper := version.IncMajor()
+	if len(parts) >= 3 {
+		upper = version.IncMinor()
+	}
+	return fmt.Sprintf(">= %s, < %s", version, upper.String()), nil
+}
+
+// matchingVersions returns the distinct versions that satisfy c, highest
+// first. Versions that are not semantic versions never match.
+func matchingVersions(c *semver.Constraints, versions []string) []string {
+	seen := map[string]bool{}
+	var matched []*semver.Version
+	for _, v := range versions {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		sv, err := semver.NewVersion(v)
+		if err != nil || !c.Check(sv) {
+			continue
+		}
+		matched = append(matched, sv)
+	}
+	sort.Sort(sort.Reverse(semver.Collection(matched)))
+
+	out := make([]string, 0, len(matched))
+	for _, v := range matched {
+		out = append(out, v.Original())
+	}
+	return out
+}
+
+// resolveVersionConstraint returns the versions matching d's
+// version_constraint, highest first. ok is false when d has none.
+func resolveVersionConstraint(d *schema.ResourceData, versions []string) (matched []string, ok bool, err error) {
+	v, set := d.GetOk("version_constraint")
+	if !set {
+		return nil, false, nil
+	}
+	c, err := parseVersionConstraint(v.(string))
+	if err != nil {
+		return nil, true, err
+	}
+	return matchingVersions(c, versions), true, nil
+}
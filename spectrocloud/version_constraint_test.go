@@ -0,0 +1,44 @@
+package spectrocloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchingVersions(t *testing.T) {
+	versions := []string{"1.27.9", "1.28.0", "1.28.4", "1.29.1", "1.30.0", "2.0.0", "1.4.0", "1.4.3", "1.5.0", "1.28.4", "latest", "1.31.0-rc.1"}
+
+	tests := []struct {
+		constraint string
+		want       []string
+	}{
+		{"~> 1.28", []string{"1.30.0", "1.29.1", "1.28.4", "1.28.0"}},
+		{"~> 1.28.2", []string{"1.28.4"}},
+		{"~> 1", []string{"1.30.0", "1.29.1", "1.28.4", "1.28.0", "1.27.9", "1.5.0", "1.4.3", "1.4.0"}},
+		{">=1.2.0, <2.0.0", []string{"1.30.0", "1.29.1", "1.28.4", "1.28.0", "1.27.9", "1.5.0", "1.4.3", "1.4.0"}},
+		{"latest-patch-of 1.4", []string{"1.4.3", "1.4.0"}},
+		{"latest-patch-of 1.28.1", []string{"1.28.4"}},
+		{"1.27.9 || >= 2", []string{"2.0.0", "1.27.9"}},
+		{"~> 3.0", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			c, err := parseVersionConstraint(tt.constraint)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, matchingVersions(c, versions))
+		})
+	}
+}
+
+func TestValidateVersionConstraint(t *testing.T) {
+	for _, v := range []string{"~> 1.28", ">= 1.2.0, < 2.0.0", "latest-patch-of 1.4", "1.2.3"} {
+		_, errs := validateVersionConstraint(v, "version_constraint")
+		assert.Empty(t, errs, v)
+	}
+	for _, v := range []string{"", "~> one", "latest-patch-of", ">>1"} {
+		_, errs := validateVersionConstraint(v, "version_constraint")
+		assert.NotEmpty(t, errs, v)
+	}
+}
@@ -0,0 +1,116 @@
+package spectrocloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+)
+
+// waitSettings controls how a waiter polls Palette: how long it waits
+// before the first check, how long between checks, and how that interval
+// backs off. The provider's wait_* arguments set them for every waiter;
+// a resource's wait_settings block overrides them for that resource.
+type waitSettings struct {
+	initialDelay      time.Duration
+	pollInterval      time.Duration
+	backoffMultiplier float64
+	maxPollInterval   time.Duration
+}
+
+// defaultWaitSettings are the provider's defaults: a 30 second initial
+// delay, then a check every 10 seconds.
+var defaultWaitSettings = waitSettings{
+	initialDelay:      30 * time.Second,
+	pollInterval:      10 * time.Second,
+	backoffMultiplier: 1,
+}
+
+// providerWaitSettings reads the provider's wait_* arguments. They are
+// validated by the schema, so unparsable values cannot reach here.
+func providerWaitSettings(d *schema.ResourceData) waitSettings {
+	s := defaultWaitSettings
+	if v, ok := d.Get("wait_initial_delay").(string); ok && v != "" {
+		s.initialDelay, _ = time.ParseDuration(v)
+	}
+	if v, ok := d.Get("wait_poll_interval").(string); ok && v != "" {
+		s.pollInterval, _ = time.ParseDuration(v)
+	}
+	if v, ok := d.Get("wait_backoff_multiplier").(float64); ok && v >= 1 {
+		s.backoffMultiplier = v
+	}
+	if v, ok := d.Get("wait_max_poll_interval").(string); ok && v != "" {
+		s.maxPollInterval, _ = time.ParseDuration(v)
+	}
+	return s
+}
+
+// waitSettingsFor returns the wait settings for a waiter run on behalf of
+// d: the provider's, overridden field by field by d's wait_settings block
+// when the resource has one. d may be nil.
+func waitSettingsFor(m interface{}, d *schema.ResourceData) waitSettings {
+	s := defaultWaitSettings
+	if meta, ok := m.(*providerMeta); ok {
+		s = meta.wait
+	}
+	if d == nil {
+		return s
+	}
+	block, _ := d.Get("wait_settings").([]interface{})
+	if len(block) == 0 || block[0] == nil {
+		return s
+	}
+	o := block[0].(map[string]interface{})
+	if v, _ := o["initial_delay"].(string); v != "" {
+		s.initialDelay, _ = time.ParseDuration(v)
+	}
+	if v, _ := o["poll_interval"].(string); v != "" {
+		s.pollInterval, _ = time.ParseDuration(v)
+	}
+	if v, _ := o["backoff_multiplier"].(float64); v >= 1 {
+		s.backoffMultiplier = v
+	}
+	if v, _ := o["max_poll_interval"].(string); v != "" {
+		s.maxPollInterval, _ = time.ParseDuration(v)
+	}
+	return s
+}
+
+// apply sets conf's initial delay and poll interval from s. With a backoff
+// multiplier above 1, each check lengthens the interval before the next,
+// up to maxPollInterval.
+func (s waitSettings) apply(conf *retry.StateChangeConf) {
+	conf.Delay = resolveWaitDelay(s.initialDelay)
+	conf.MinTimeout = s.pollInterval
+	conf.PollInterval = s.pollInterval
+	if s.backoffMultiplier <= 1 {
+		return
+	}
+
+	refresh := conf.Refresh
+	next := s.pollInterval
+	conf.Refresh = func() (interface{}, string, error) {
+		// Refresh runs on the waiter's polling goroutine, which reads
+		// PollInterval only once Refresh has returned.
+		conf.PollInterval = next
+		next = s.nextPollInterval(next)
+		return refresh()
+	}
+}
+
+func (s waitSettings) nextPollInterval(d time.Duration) time.Duration {
+	next := time.Duration(float64(d) * s.backoffMultiplier)
+	limit := schemas.MaxWaitPollInterval
+	if s.maxPollInterval > 0 && s.maxPollInterval < limit {
+		limit = s.maxPollInterval
+	}
+	if limit < s.pollInterval {
+		limit = s.pollInterval
+	}
+	if next > limit {
+		next = limit
+	}
+	return next
+}
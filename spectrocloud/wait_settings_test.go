@@ -0,0 +1,115 @@
+package spectrocloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud/schemas"
+)
+
+func TestProviderWaitSettings(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, New("111.111.111")().Schema, map[string]interface{}{})
+		assert.Equal(t, defaultWaitSettings, providerWaitSettings(d))
+	})
+
+	t.Run("environment", func(t *testing.T) {
+		t.Setenv("SPECTROCLOUD_WAIT_INITIAL_DELAY", "2m")
+		t.Setenv("SPECTROCLOUD_WAIT_POLL_INTERVAL", "15s")
+		t.Setenv("SPECTROCLOUD_WAIT_BACKOFF_MULTIPLIER", "1.5")
+		t.Setenv("SPECTROCLOUD_WAIT_MAX_POLL_INTERVAL", "1m")
+		d := schema.TestResourceDataRaw(t, New("111.111.111")().Schema, map[string]interface{}{})
+		assert.Equal(t, waitSettings{
+			initialDelay:      2 * time.Minute,
+			pollInterval:      15 * time.Second,
+			backoffMultiplier: 1.5,
+			maxPollInterval:   time.Minute,
+		}, providerWaitSettings(d))
+	})
+}
+
+func TestWaitSettingsFor(t *testing.T) {
+	meta := newProviderMeta(nil, projectUID)
+	meta.wait = waitSettings{initialDelay: time.Minute, pollInterval: 20 * time.Second, backoffMultiplier: 2, maxPollInterval: time.Minute}
+
+	assert.Equal(t, defaultWaitSettings, waitSettingsFor(nil, nil))
+	assert.Equal(t, meta.wait, waitSettingsFor(meta, nil))
+
+	d := resourceClusterAws().TestResourceData()
+	assert.Equal(t, meta.wait, waitSettingsFor(meta, d), "no wait_settings block inherits the provider's")
+
+	require.NoError(t, d.Set("wait_settings", []interface{}{map[string]interface{}{
+		"poll_interval":      "1m",
+		"backoff_multiplier": 1.0,
+	}}))
+	assert.Equal(t, waitSettings{
+		initialDelay:      time.Minute,
+		pollInterval:      time.Minute,
+		backoffMultiplier: 1,
+		maxPollInterval:   time.Minute,
+	}, waitSettingsFor(meta, d))
+}
+
+func TestWaitSettingsNextPollInterval(t *testing.T) {
+	tests := []struct {
+		name string
+		s    waitSettings
+		in   time.Duration
+		want time.Duration
+	}{
+		{"grows", waitSettings{pollInterval: 10 * time.Second, backoffMultiplier: 2}, 10 * time.Second, 20 * time.Second},
+		{"capped by max_poll_interval", waitSettings{pollInterval: 10 * time.Second, backoffMultiplier: 2, maxPollInterval: 15 * time.Second}, 10 * time.Second, 15 * time.Second},
+		{"capped by the SDK limit", waitSettings{pollInterval: 10 * time.Second, backoffMultiplier: 4}, 2 * time.Minute, schemas.MaxWaitPollInterval},
+		{"max below poll_interval", waitSettings{pollInterval: 10 * time.Second, backoffMultiplier: 2, maxPollInterval: 5 * time.Second}, 10 * time.Second, 10 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.s.nextPollInterval(tt.in))
+		})
+	}
+}
+
+func TestWaitSettingsApplyBackoff(t *testing.T) {
+	var intervals []time.Duration
+	conf := &retry.StateChangeConf{
+		Pending: []string{"Pending"},
+		Target:  []string{"Done"},
+		Timeout: 10 * time.Second,
+	}
+	conf.Refresh = func() (interface{}, string, error) {
+		if len(intervals) == 5 {
+			return "done", "Done", nil
+		}
+		intervals = append(intervals, conf.PollInterval)
+		return "pending", "Pending", nil
+	}
+	waitSettings{pollInterval: 10 * time.Millisecond, backoffMultiplier: 2, maxPollInterval: 50 * time.Millisecond}.apply(conf)
+	assert.Equal(t, time.Duration(0), conf.Delay, "tests zero the initial delay")
+
+	_, err := conf.WaitForStateContext(context.Background())
+	require.NoError(t, err)
+	ms := time.Millisecond
+	assert.Equal(t, []time.Duration{10 * ms, 20 * ms, 40 * ms, 50 * ms, 50 * ms}, intervals,
+		"each check must wait longer than the last, up to max_poll_interval")
+}
+
+func TestValidateWaitDurations(t *testing.T) {
+	for _, v := range []string{"1s", "10s", "2m59s"} {
+		_, errs := schemas.ValidateWaitPollInterval(v, "wait_poll_interval")
+		assert.Empty(t, errs, v)
+	}
+	for _, v := range []string{"", "10", "500ms", "3m", "-1s"} {
+		_, errs := schemas.ValidateWaitPollInterval(v, "wait_poll_interval")
+		assert.NotEmpty(t, errs, v)
+	}
+	_, errs := schemas.ValidateWaitDelay("0s", "wait_initial_delay")
+	assert.Empty(t, errs)
+	_, errs = schemas.ValidateWaitDelay("-5s", "wait_initial_delay")
+	assert.NotEmpty(t, errs)
+}
@@ -0,0 +1,193 @@
+// Package acceptance runs the provider end to end — plan, apply, refresh,
+// import and destroy through the Terraform plugin protocol — against the
+// in-process mock Palette API.
+//
+// The unit tests under spectrocloud/ call resourceXCreate and friends
+// directly with schema.TestResourceDataRaw, which never exercises the
+// diff/plan machinery. Tests here drive a real Terraform binary through
+// helper/resource instead, so a perpetual diff shows up as a failing
+// "plan was not empty" check after apply rather than going unnoticed.
+//
+// Usage, from a _test.go file in this package:
+//
+//	func TestAccSSHKey(t *testing.T) {
+//		acceptance.Test(t, resource.TestCase{
+//			Steps: []resource.TestStep{
+//				{Config: acceptance.Config(`resource "spectrocloud_ssh_key" "k" { ... }`)},
+//				acceptance.ImportStep("spectrocloud_ssh_key.k"),
+//			},
+//		})
+//	}
+//
+// Like every helper/resource test these only run with TF_ACC=1, and need a
+// terraform binary on PATH or at TF_ACC_TERRAFORM_PATH. The mock server
+// runs in stateful mode on ephemeral ports, so it never collides with the
+// fixed ports spectrocloud's TestMain binds.
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/spectrocloud"
+	"github.com/spectrocloud/terraform-provider-spectrocloud/tests/mockApiServer/mockserver"
+)
+
+// ProviderName is the provider's local name in test configurations.
+const ProviderName = "spectrocloud"
+
+// server is the mock started by Main. It stays nil when TF_ACC is unset,
+// since resource.Test skips every case in that mode anyway.
+var server *mockserver.Server
+
+// Collections returns the stateful collections the harness serves: the
+// mockserver defaults plus the simple user-asset endpoints used by this
+// package's own tests. Add to it when a new resource needs its writes to
+// round-trip.
+func Collections() []mockserver.Collection {
+	return append(mockserver.DefaultCollections(),
+		mockserver.Collection{Name: "sshkeys", Path: "/v1/users/assets/sshkeys"},
+	)
+}
+
+// Main is the body of this package's TestMain: it starts the mock, runs the
+// tests and stops it again.
+func Main(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		srv, err := mockserver.StartWith(mockserver.DefaultPositiveRoutes(), mockserver.DefaultNegativeRoutes(),
+			mockserver.WithPorts(0, 0),
+			mockserver.WithStatefulCollections(Collections()...))
+		if err != nil {
+			fmt.Printf("acceptance: start mock api server: %v\n", err)
+			os.Exit(1)
+		}
+		server = srv
+	}
+
+	code := m.Run()
+
+	server.Stop()
+	os.Exit(code)
+}
+
+// Store returns the mock's entity store so tests can seed entities before a
+// step or assert on what the provider wrote.
+func Store() *mockserver.Store {
+	return server.Store()
+}
+
+// ProviderFactories returns a fresh provider from spectrocloud.New per
+// Terraform invocation, as helper/resource requires.
+func ProviderFactories() map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		ProviderName: func() (*schema.Provider, error) {
+			return spectrocloud.New("acctest")(), nil
+		},
+	}
+}
+
+// ProviderConfig returns the provider block pointing at the running mock.
+func ProviderConfig() string {
+	return fmt.Sprintf(`
+provider %q {
+  host                      = %q
+  api_key                   = %q
+  project_name              = "Default"
+  retry_attempts            = 1
+  ignore_insecure_tls_error = true
+}
+`, ProviderName, server.PositiveAddr(), mockserver.APIKey)
+}
+
+// Config prepends ProviderConfig to an HCL body. Call it while building the
+// TestCase, never at package init: the mock's address is only known once
+// Main has started it. Without TF_ACC the host is empty, which is harmless
+// because resource.Test skips before applying anything.
+func Config(hcl string) string {
+	return ProviderConfig() + hcl
+}
+
+// Test runs tc through resource.Test with the harness defaults filled in:
+// the provider factories and a PreCheck that the mock is up. Each Config
+// step is followed by the framework's own empty-plan check, which is what
+// catches perpetual diffs; leave ExpectNonEmptyPlan unset unless the diff is
+// the thing under test.
+func Test(t *testing.T, tc resource.TestCase) {
+	t.Helper()
+	if tc.ProviderFactories == nil {
+		tc.ProviderFactories = ProviderFactories()
+	}
+	precheck := tc.PreCheck
+	tc.PreCheck = func() {
+		if server == nil {
+			t.Fatal("acceptance: mock api server not running; is TestMain calling acceptance.Main?")
+		}
+		if precheck != nil {
+			precheck()
+		}
+	}
+	resource.Test(t, tc)
+}
+
+// ImportStep returns a step that imports resourceName by its ID and checks
+// the imported state matches what apply produced. ignore lists attributes
+// the import cannot recover, e.g. write-only secrets or timeouts.
+func ImportStep(resourceName string, ignore ...string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:            resourceName,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: ignore,
+	}
+}
+
+// ImportStepWithContext is ImportStep for resources whose import ID is
+// "<uid>:<context>", such as the cluster and cluster profile resources.
+func ImportStepWithContext(resourceName, context string, ignore ...string) resource.TestStep {
+	step := ImportStep(resourceName, ignore...)
+	step.ImportStateIdFunc = func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("%s not found in state", resourceName)
+		}
+		return rs.Primary.ID + ":" + context, nil
+	}
+	return step
+}
+
+// CheckStored returns a check that the resource's ID is present in the
+// given store collection — i.e. Create really went through the API rather
+// than being satisfied by a static fixture.
+func CheckStored(resourceName, collection string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found in state", resourceName)
+		}
+		if _, ok := Store().Get(collection, rs.Primary.ID); !ok {
+			return fmt.Errorf("%s: %s %q not found in mock store", resourceName, collection, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+// CheckDestroyed returns a CheckDestroy func asserting that no resource of
+// resourceType in the final state is still held in the store collection.
+func CheckDestroyed(resourceType, collection string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			if _, ok := Store().Get(collection, rs.Primary.ID); ok {
+				return fmt.Errorf("%s %q still present in mock store after destroy", resourceType, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
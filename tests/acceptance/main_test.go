@@ -0,0 +1,7 @@
+package acceptance
+
+import "testing"
+
+func TestMain(m *testing.M) {
+	Main(m)
+}
@@ -0,0 +1,46 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccClusterProfile_addon(t *testing.T) {
+	const name = "spectrocloud_cluster_profile.test"
+
+	Test(t, resource.TestCase{
+		CheckDestroy: CheckDestroyed("spectrocloud_cluster_profile", "clusterprofiles"),
+		Steps: []resource.TestStep{
+			{
+				Config: Config(`
+resource "spectrocloud_cluster_profile" "test" {
+  name        = "acc-addon-profile"
+  description = "acceptance"
+  cloud       = "all"
+  type        = "add-on"
+  version     = "1.0.0"
+  tags        = ["env:acc"]
+
+  pack {
+    name   = "spectro-byo-manifest"
+    tag    = "1.0.x"
+    uid    = "5faad584f244cfe0b98cf489"
+    type   = "spectro"
+    values = "manifests: {}"
+  }
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					CheckStored(name, "clusterprofiles"),
+					resource.TestCheckResourceAttr(name, "name", "acc-addon-profile"),
+					resource.TestCheckResourceAttr(name, "pack.#", "1"),
+				),
+			},
+			// Read does not refresh description from the profile's
+			// annotations, and skip_destroy is a provider-side knob with no
+			// API counterpart, so neither survives an import.
+			ImportStepWithContext(name, "project", "description", "skip_destroy"),
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSSHKey_basic(t *testing.T) {
+	const name = "spectrocloud_ssh_key.test"
+
+	Test(t, resource.TestCase{
+		CheckDestroy: CheckDestroyed("spectrocloud_ssh_key", "sshkeys"),
+		Steps: []resource.TestStep{
+			{
+				Config: Config(`
+resource "spectrocloud_ssh_key" "test" {
+  name    = "acc-ssh-key"
+  context = "project"
+  ssh_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQacc acc@example"
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					CheckStored(name, "sshkeys"),
+					resource.TestCheckResourceAttr(name, "name", "acc-ssh-key"),
+				),
+			},
+			{
+				Config: Config(`
+resource "spectrocloud_ssh_key" "test" {
+  name    = "acc-ssh-key-renamed"
+  context = "project"
+  ssh_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQacc acc@example"
+}
+`),
+				Check: resource.TestCheckResourceAttr(name, "name", "acc-ssh-key-renamed"),
+			},
+			ImportStep(name),
+		},
+	})
+}
@@ -5,9 +5,26 @@
 // without going through `go test`. The Go unit tests do NOT use this
 // binary — they import tests/mockApiServer/mockserver directly and start
 // the servers in-process from TestMain.
+//
+// Flags:
+//
+//	-stateful            serve clusters, cluster profiles and workspaces from
+//	                     an in-memory store (see mockserver.DefaultCollections)
+//	-fixtures <file>     replay a fixture file ahead of the built-in routes
+//	-record <host>       instead of mocking, proxy to a real Palette host and
+//	                     record its responses
+//	-record-out <file>   where -record writes fixtures on shutdown
+//
+// Faults are injected at runtime through the admin endpoint, e.g.
+//
+//	curl -k -H 'ApiKey: 12345' -d '{"path":"/v1/spectroclusters/{uid}","kind":"status","status":503,"count":2}' \
+//	  https://127.0.0.1:8088/__mock/faults
+//
+// See mockserver.FaultAdminPath for the full set of operations.
 package main
 
 import (
+	"flag"
 	"log"
 	"os"
 	"os/signal"
@@ -17,19 +34,65 @@ import (
 )
 
 func main() {
-	srv, err := mockserver.Start()
+	stateful := flag.Bool("stateful", false, "serve clusters, cluster profiles and workspaces from an in-memory store")
+	fixtures := flag.String("fixtures", "", "fixture file to replay ahead of the built-in routes")
+	record := flag.String("record", "", "Palette host to proxy to and record from, instead of mocking")
+	recordOut := flag.String("record-out", "fixtures.json", "file -record writes captured fixtures to on shutdown")
+	flag.Parse()
+
+	if *record != "" {
+		runRecorder(*record, *recordOut)
+		return
+	}
+
+	positive := mockserver.DefaultPositiveRoutes()
+	if *fixtures != "" {
+		recorded, err := mockserver.LoadFixtures(*fixtures)
+		if err != nil {
+			log.Fatalf("mock api server failed to load fixtures: %v", err)
+		}
+		positive = append(recorded, positive...)
+	}
+
+	var opts []mockserver.Option
+	if *stateful {
+		opts = append(opts, mockserver.WithStatefulCollections(mockserver.DefaultCollections()...))
+	}
+
+	srv, err := mockserver.StartWith(positive, mockserver.DefaultNegativeRoutes(), opts...)
 	if err != nil {
 		log.Fatalf("mock api server failed to start: %v", err)
 	}
 	log.Printf("Mock API server listening on https://127.0.0.1:%d (positive) and https://127.0.0.1:%d (negative)",
 		mockserver.PositivePort, mockserver.NegativePort)
 
-	// Block until interrupted so the shell script continues to work as a
-	// long-running background process. `kill <pid>` triggers graceful stop.
-	sig := make(chan os.Signal, 1)
-	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
-	<-sig
+	waitForSignal()
 
 	srv.Stop()
 	log.Println("Mock API server stopped")
 }
+
+func runRecorder(upstream, out string) {
+	rec, err := mockserver.StartRecorder(upstream)
+	if err != nil {
+		log.Fatalf("recorder failed to start: %v", err)
+	}
+	log.Printf("Recording proxy listening on https://127.0.0.1:%d, forwarding to %s", mockserver.PositivePort, upstream)
+
+	waitForSignal()
+
+	rec.Stop()
+	if err := rec.WriteFixtures(out); err != nil {
+		log.Fatalf("recorder failed to write fixtures: %v", err)
+	}
+	log.Printf("Recorded %d fixtures to %s", len(rec.Fixtures()), out)
+}
+
+// waitForSignal blocks until interrupted so the shell script continues to
+// work as a long-running background process. `kill <pid>` triggers
+// graceful stop.
+func waitForSignal() {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	<-sig
+}
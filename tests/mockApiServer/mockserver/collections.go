@@ -0,0 +1,129 @@
+package mockserver
+
+import (
+	"net/http"
+	"path"
+	"strings"
+)
+
+// clusterCloudTypes are the per-cloud create endpoints under
+// /v1/spectroclusters. A POST to any of them lands in the same
+// "spectroclusters" collection, which is how Palette itself models it —
+// create is per-cloud, read/delete are not.
+var clusterCloudTypes = []string{
+	"aks",
+	"apache-cloudstack",
+	"aws",
+	"azure",
+	"edge-native",
+	"eks",
+	"gcp",
+	"gke",
+	"maas",
+	"virtual",
+	"vsphere",
+}
+
+// DefaultCollections returns the stateful collections StartStateful serves:
+// clusters, cluster profiles and workspaces. Callers wanting a different
+// mix pass their own slice to WithStatefulCollections.
+func DefaultCollections() []Collection {
+	clusterCreatePaths := make([]string, 0, len(clusterCloudTypes))
+	for _, cloud := range clusterCloudTypes {
+		clusterCreatePaths = append(clusterCreatePaths, "/v1/spectroclusters/"+cloud)
+	}
+
+	return []Collection{
+		{
+			Name:        "spectroclusters",
+			Path:        "/v1/spectroclusters",
+			CreatePaths: clusterCreatePaths,
+			Materialize: materializeCluster,
+		},
+		{
+			Name:        "clusterprofiles",
+			Path:        "/v1/clusterprofiles",
+			Materialize: materializeClusterProfile,
+		},
+		{
+			Name: "workspaces",
+			Path: "/v1/workspaces",
+		},
+	}
+}
+
+// materializeCluster turns a V1Spectro<Cloud>ClusterEntity into something the
+// provider's read path accepts as a V1SpectroCluster: the cloud type comes
+// from the create endpoint, the cloud config ref points at the static
+// cloudconfig fixtures (which answer for any config UID), and the cluster
+// starts out Running so waitForClusterCreation resolves on the first poll.
+func materializeCluster(r *http.Request, uid string, entity map[string]interface{}) {
+	spec := nestedMap(entity, "spec")
+	if _, ok := spec["cloudType"]; !ok && r.Method == http.MethodPost {
+		spec["cloudType"] = path.Base(r.URL.Path)
+	}
+	if _, ok := spec["cloudConfigRef"]; !ok {
+		spec["cloudConfigRef"] = map[string]interface{}{"uid": uid}
+	}
+
+	metadata := nestedMap(entity, "metadata")
+	annotations := nestedMap(metadata, "annotations")
+	if _, ok := annotations["scope"]; !ok {
+		annotations["scope"] = scopeOf(r)
+	}
+
+	status := nestedMap(entity, "status")
+	if _, ok := status["state"]; !ok {
+		status["state"] = "Running"
+	}
+}
+
+// materializeClusterProfile mirrors the create payload's spec.template into
+// spec.published, which is where every provider read looks, and marks the
+// profile published. Draft-vs-published is not modeled: the provider always
+// publishes straight after create. Pack entities carry their pack UID as
+// "uid" while the published V1PackRef calls it "packUid", so that is copied
+// across too.
+func materializeClusterProfile(r *http.Request, _ string, entity map[string]interface{}) {
+	spec := nestedMap(entity, "spec")
+	if template, ok := spec["template"].(map[string]interface{}); ok {
+		published := deepCopy(template)
+		if packs, ok := published["packs"].([]interface{}); ok {
+			for _, p := range packs {
+				pack, ok := p.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if _, ok := pack["packUid"]; !ok && pack["uid"] != nil {
+					pack["packUid"] = pack["uid"]
+				}
+			}
+		}
+		if version, ok := spec["version"]; ok {
+			published["profileVersion"] = version
+		}
+		if metadata, ok := entity["metadata"].(map[string]interface{}); ok {
+			published["name"] = metadata["name"]
+		}
+		spec["published"] = published
+	}
+
+	metadata := nestedMap(entity, "metadata")
+	annotations := nestedMap(metadata, "annotations")
+	if _, ok := annotations["scope"]; !ok {
+		annotations["scope"] = scopeOf(r)
+	}
+
+	status := nestedMap(entity, "status")
+	status["isPublished"] = true
+}
+
+// scopeOf reports the Palette scope the SDK addressed the request to. The
+// SDK sends the ProjectUid header for project-scoped calls and omits it for
+// tenant-scoped ones.
+func scopeOf(r *http.Request) string {
+	if strings.TrimSpace(r.Header.Get("ProjectUid")) != "" {
+		return "project"
+	}
+	return "tenant"
+}
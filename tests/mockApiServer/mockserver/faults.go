@@ -0,0 +1,345 @@
+package mockserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// FaultKind selects what an injected fault does to a matching request.
+type FaultKind string
+
+const (
+	// FaultDelay only adds latency; the request is then served normally.
+	FaultDelay FaultKind = "delay"
+	// FaultStatus answers with Fault.Status and a V1Error body instead of
+	// serving the request. A 429 also carries a Retry-After header when
+	// Fault.RetryAfter is set.
+	FaultStatus FaultKind = "status"
+	// FaultReset drops the TCP connection with an RST before any response
+	// is written — what a crashing load balancer looks like to the client.
+	FaultReset FaultKind = "reset"
+	// FaultPartialJSON serves the request but truncates the body halfway,
+	// so the client's JSON decode fails with an unexpected EOF.
+	FaultPartialJSON FaultKind = "partial_json"
+)
+
+// Fault is one fault-injection policy. It matches requests by Method (any
+// method when empty) and Path, a mux path template such as
+// "/v1/spectroclusters/{uid}" — the same syntax routes.Route uses.
+//
+// A matching request fires the fault with the given Probability (0 is
+// treated as 1, i.e. always) until it has fired Count times (0 means no
+// limit). Delay is applied before the fault's action for every kind, so a
+// slow 503 is Kind FaultStatus with a Delay.
+type Fault struct {
+	Method      string
+	Path        string
+	Kind        FaultKind
+	Status      int
+	RetryAfter  time.Duration
+	Delay       time.Duration
+	Probability float64
+	Count       int
+}
+
+// FaultState reports an injected fault and how many times it has fired.
+type FaultState struct {
+	ID   string
+	Hits int
+	Fault
+}
+
+// faultJSON is the wire form the admin endpoint accepts and returns.
+// Durations travel as Go duration strings ("250ms", "2s").
+type faultJSON struct {
+	ID          string    `json:"id,omitempty"`
+	Hits        int       `json:"hits,omitempty"`
+	Method      string    `json:"method,omitempty"`
+	Path        string    `json:"path"`
+	Kind        FaultKind `json:"kind"`
+	Status      int       `json:"status,omitempty"`
+	RetryAfter  string    `json:"retry_after,omitempty"`
+	Delay       string    `json:"delay,omitempty"`
+	Probability float64   `json:"probability,omitempty"`
+	Count       int       `json:"count,omitempty"`
+}
+
+// FaultAdminPath is the admin endpoint on each listener. POST a fault as
+// JSON to inject it (the response carries its id), GET to list faults with
+// hit counts, DELETE to clear them all, or DELETE FaultAdminPath/<id> to
+// remove one. The ApiKey check applies as for any other route.
+const FaultAdminPath = "/__mock/faults"
+
+type injectedFault struct {
+	id    string
+	route *mux.Route
+	hits  int
+	Fault
+}
+
+// faultInjector holds the active fault policies shared by both listeners.
+type faultInjector struct {
+	mu     sync.Mutex
+	nextID int
+	faults []*injectedFault
+}
+
+func (fi *faultInjector) add(f Fault) (string, error) {
+	switch f.Kind {
+	case FaultDelay, FaultReset, FaultPartialJSON:
+	case FaultStatus:
+		if f.Status < 400 || f.Status > 599 {
+			return "", fmt.Errorf("mockserver: fault status %d is not an error status", f.Status)
+		}
+	default:
+		return "", fmt.Errorf("mockserver: unknown fault kind %q", f.Kind)
+	}
+	if f.Path == "" {
+		return "", fmt.Errorf("mockserver: fault path is required")
+	}
+	route := mux.NewRouter().Path(f.Path)
+	if err := route.GetError(); err != nil {
+		return "", fmt.Errorf("mockserver: fault path %q: %w", f.Path, err)
+	}
+
+	fi.mu.Lock()
+	defer fi.mu.Unlock()
+	fi.nextID++
+	id := strconv.Itoa(fi.nextID)
+	fi.faults = append(fi.faults, &injectedFault{id: id, route: route, Fault: f})
+	return id, nil
+}
+
+func (fi *faultInjector) remove(id string) bool {
+	fi.mu.Lock()
+	defer fi.mu.Unlock()
+	for i, f := range fi.faults {
+		if f.id == id {
+			fi.faults = append(fi.faults[:i], fi.faults[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (fi *faultInjector) clear() {
+	fi.mu.Lock()
+	defer fi.mu.Unlock()
+	fi.faults = nil
+}
+
+func (fi *faultInjector) list() []FaultState {
+	fi.mu.Lock()
+	defer fi.mu.Unlock()
+	out := make([]FaultState, 0, len(fi.faults))
+	for _, f := range fi.faults {
+		out = append(out, FaultState{ID: f.id, Hits: f.hits, Fault: f.Fault})
+	}
+	return out
+}
+
+// pick returns the first fault that matches r and elects to fire, counting
+// the hit. Faults are checked in injection order.
+func (fi *faultInjector) pick(r *http.Request) *Fault {
+	fi.mu.Lock()
+	defer fi.mu.Unlock()
+	for _, f := range fi.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if f.Count > 0 && f.hits >= f.Count {
+			continue
+		}
+		if !f.route.Match(r, &mux.RouteMatch{}) {
+			continue
+		}
+		if f.Probability > 0 && f.Probability < 1 && rand.Float64() >= f.Probability { // #nosec G404 -- test fault sampling
+			continue
+		}
+		f.hits++
+		fault := f.Fault
+		return &fault
+	}
+	return nil
+}
+
+// middleware applies the active faults in front of next. The admin
+// endpoint is never faulted, so a test can always clear a policy it set.
+func (fi *faultInjector) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f := fi.pick(r)
+		if f == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if f.Delay > 0 {
+			select {
+			case <-time.After(f.Delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		switch f.Kind {
+		case FaultStatus:
+			if f.Status == http.StatusTooManyRequests && f.RetryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(int(f.RetryAfter.Round(time.Second)/time.Second)))
+			}
+			writeJSON(w, f.Status, map[string]string{
+				"code":    strconv.Itoa(f.Status),
+				"message": "injected fault",
+				"ref":     "ref-injected-fault",
+			})
+		case FaultReset:
+			resetConnection(w)
+		case FaultPartialJSON:
+			buf := &bufferedResponse{header: http.Header{}}
+			next.ServeHTTP(buf, r)
+			for k, v := range buf.header {
+				w.Header()[k] = v
+			}
+			w.Header().Del("Content-Length")
+			w.WriteHeader(buf.statusCode())
+			body := buf.body.Bytes()
+			_, _ = w.Write(body[:len(body)/2])
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// resetConnection hijacks the connection and closes it with SO_LINGER 0 so
+// the peer sees ECONNRESET rather than a clean EOF.
+func resetConnection(w http.ResponseWriter) {
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		panic(http.ErrAbortHandler)
+	}
+	conn, _, err := hj.Hijack()
+	if err != nil {
+		return
+	}
+	raw := conn
+	if nc, ok := conn.(interface{ NetConn() net.Conn }); ok {
+		raw = nc.NetConn()
+	}
+	if tcp, ok := raw.(*net.TCPConn); ok {
+		_ = tcp.SetLinger(0)
+	}
+	_ = raw.Close()
+}
+
+// bufferedResponse captures a handler's response so FaultPartialJSON can
+// truncate it.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header         { return b.header }
+func (b *bufferedResponse) Write(p []byte) (int, error) { return b.body.Write(p) }
+func (b *bufferedResponse) WriteHeader(status int)      { b.status = status }
+
+func (b *bufferedResponse) statusCode() int {
+	if b.status == 0 {
+		return http.StatusOK
+	}
+	return b.status
+}
+
+// adminRoutes registers the fault admin endpoint on router, a subrouter
+// rooted at FaultAdminPath.
+func (fi *faultInjector) adminRoutes(router *mux.Router) {
+	router.HandleFunc("", func(w http.ResponseWriter, r *http.Request) {
+		var in faultJSON
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+			return
+		}
+		f, err := in.toFault()
+		if err == nil {
+			in.ID, err = fi.add(f)
+		}
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusCreated, map[string]string{"id": in.ID})
+	}).Methods(http.MethodPost)
+
+	router.HandleFunc("", func(w http.ResponseWriter, r *http.Request) {
+		states := fi.list()
+		out := make([]faultJSON, 0, len(states))
+		for _, s := range states {
+			out = append(out, fromFaultState(s))
+		}
+		writeJSON(w, http.StatusOK, out)
+	}).Methods(http.MethodGet)
+
+	router.HandleFunc("", func(w http.ResponseWriter, r *http.Request) {
+		fi.clear()
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods(http.MethodDelete)
+
+	router.HandleFunc("/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !fi.remove(mux.Vars(r)["id"]) {
+			writeJSON(w, http.StatusNotFound, map[string]string{"message": "fault not found"})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods(http.MethodDelete)
+}
+
+func (in faultJSON) toFault() (Fault, error) {
+	f := Fault{
+		Method:      in.Method,
+		Path:        in.Path,
+		Kind:        in.Kind,
+		Status:      in.Status,
+		Probability: in.Probability,
+		Count:       in.Count,
+	}
+	var err error
+	if in.Delay != "" {
+		if f.Delay, err = time.ParseDuration(in.Delay); err != nil {
+			return Fault{}, fmt.Errorf("mockserver: fault delay: %w", err)
+		}
+	}
+	if in.RetryAfter != "" {
+		if f.RetryAfter, err = time.ParseDuration(in.RetryAfter); err != nil {
+			return Fault{}, fmt.Errorf("mockserver: fault retry_after: %w", err)
+		}
+	}
+	return f, nil
+}
+
+func fromFaultState(s FaultState) faultJSON {
+	out := faultJSON{
+		ID:          s.ID,
+		Hits:        s.Hits,
+		Method:      s.Method,
+		Path:        s.Path,
+		Kind:        s.Kind,
+		Status:      s.Status,
+		Probability: s.Probability,
+		Count:       s.Count,
+	}
+	if s.Delay > 0 {
+		out.Delay = s.Delay.String()
+	}
+	if s.RetryAfter > 0 {
+		out.RetryAfter = s.RetryAfter.String()
+	}
+	return out
+}
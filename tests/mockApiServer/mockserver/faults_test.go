@@ -0,0 +1,127 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFaultHandler() (http.Handler, *faultInjector) {
+	fi := &faultInjector{}
+	return newHandler(DefaultPositiveRoutes(), options{faults: fi}), fi
+}
+
+func TestFaultStatusFiresCountTimes(t *testing.T) {
+	h, fi := newFaultHandler()
+	_, err := fi.add(Fault{Method: http.MethodGet, Path: "/v1/workspaces/{uid}", Kind: FaultStatus,
+		Status: http.StatusTooManyRequests, RetryAfter: 3 * time.Second, Count: 1})
+	require.NoError(t, err)
+
+	rec := doJSON(t, h, http.MethodGet, "/v1/workspaces/ws-1", "")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "3", rec.Header().Get("Retry-After"))
+	assert.Equal(t, "429", decodeObject(t, rec)["code"])
+
+	rec = doJSON(t, h, http.MethodGet, "/v1/workspaces/ws-1", "")
+	assert.Equal(t, http.StatusOK, rec.Code, "fault is spent after Count hits")
+
+	// Other methods and paths were never affected.
+	rec = doJSON(t, h, http.MethodGet, "/v1/workspaces", "")
+	assert.NotEqual(t, http.StatusTooManyRequests, rec.Code)
+
+	states := fi.list()
+	require.Len(t, states, 1)
+	assert.Equal(t, 1, states[0].Hits)
+}
+
+func TestFaultDelayAndPartialJSON(t *testing.T) {
+	h, fi := newFaultHandler()
+	_, err := fi.add(Fault{Path: "/v1/workspaces/{uid}", Kind: FaultPartialJSON, Delay: 50 * time.Millisecond})
+	require.NoError(t, err)
+
+	start := time.Now()
+	rec := doJSON(t, h, http.MethodGet, "/v1/workspaces/ws-1", "")
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var out map[string]interface{}
+	assert.Error(t, json.Unmarshal(rec.Body.Bytes(), &out), "body should be truncated")
+	assert.True(t, strings.HasPrefix(rec.Body.String(), "{"))
+}
+
+func TestFaultResetDropsConnection(t *testing.T) {
+	h, fi := newFaultHandler()
+	_, err := fi.add(Fault{Path: "/v1/workspaces/{uid}", Kind: FaultReset, Count: 1})
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	get := func() (*http.Response, error) {
+		req, _ := http.NewRequest(http.MethodGet, srv.URL+"/v1/workspaces/ws-1", nil)
+		req.Header.Set("ApiKey", APIKey)
+		return srv.Client().Do(req)
+	}
+
+	_, err = get()
+	require.Error(t, err)
+
+	res, err := get()
+	require.NoError(t, err)
+	_ = res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestFaultAdminEndpoint(t *testing.T) {
+	h, fi := newFaultHandler()
+
+	rec := doJSON(t, h, http.MethodPost, FaultAdminPath,
+		`{"method":"DELETE","path":"/v1/workspaces/{uid}","kind":"status","status":503,"delay":"10ms","probability":1}`)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	id := decodeObject(t, rec)["id"].(string)
+
+	rec = doJSON(t, h, http.MethodDelete, "/v1/workspaces/ws-1", "")
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	rec = doJSON(t, h, http.MethodGet, FaultAdminPath, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body, _ := io.ReadAll(rec.Body)
+	var listed []faultJSON
+	require.NoError(t, json.Unmarshal(body, &listed))
+	require.Len(t, listed, 1)
+	assert.Equal(t, id, listed[0].ID)
+	assert.Equal(t, 1, listed[0].Hits)
+	assert.Equal(t, "10ms", listed[0].Delay)
+
+	rec = doJSON(t, h, http.MethodPost, FaultAdminPath, `{"path":"/v1/x","kind":"status","status":200}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = doJSON(t, h, http.MethodPost, FaultAdminPath, `{"path":"/v1/x","kind":"explode"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = doJSON(t, h, http.MethodDelete, FaultAdminPath+"/"+id, "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	rec = doJSON(t, h, http.MethodDelete, FaultAdminPath+"/"+id, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Empty(t, fi.list())
+
+	// The admin endpoint sits behind the same ApiKey check as everything else.
+	forbidden := httptest.NewRecorder()
+	h.ServeHTTP(forbidden, httptest.NewRequest(http.MethodGet, FaultAdminPath, nil))
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+}
+
+func TestFaultControlsOnNilServer(t *testing.T) {
+	var s *Server
+	_, err := s.InjectFault(Fault{Path: "/v1/workspaces", Kind: FaultReset})
+	assert.Error(t, err)
+	assert.False(t, s.RemoveFault("1"))
+	s.ClearFaults()
+	assert.Nil(t, s.Faults())
+}
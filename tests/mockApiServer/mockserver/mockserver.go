@@ -11,6 +11,19 @@
 // dependency, no committed cert files needed), then serves the positive
 // route set on :8088 and the negative route set on :8888 — matching the
 // ports the tests have historically hard-coded in common_test.go.
+//
+// Two optional modes sit on top of the static routes:
+//
+//   - Stateful (StartStateful / WithStatefulCollections): an in-memory Store
+//     answers POST/GET/PUT/PATCH/DELETE for selected collections, so a
+//     Create → Read → Update → Delete flow sees its own writes. See store.go.
+//   - Recording (StartRecorder): a reverse proxy in front of a real Palette
+//     that captures responses as fixtures LoadFixtures can replay. See
+//     recorder.go.
+//
+// Independently of the mode, Server.InjectFault (or the FaultAdminPath
+// endpoint) adds latency, error statuses, connection resets or truncated
+// bodies to matching requests on both listeners. See faults.go.
 package mockserver
 
 import (
@@ -34,7 +47,8 @@ import (
 )
 
 // APIKey is the fixed key the mock accepts. Any request whose ApiKey header
-// does not match receives HTTP 403.
+// does not match receives HTTP 403, unless it authenticates with an
+// Authorization token instead (see sessions.go).
 const APIKey = "12345"
 
 // PositivePort and NegativePort are the fixed loopback ports. Tests dial
@@ -51,6 +65,132 @@ const (
 type Server struct {
 	positive *http.Server
 	negative *http.Server
+	store    *Store
+	faults   *faultInjector
+	sessions *sessionStore
+
+	positiveAddr string
+	negativeAddr string
+}
+
+// PositiveAddr returns the host:port the positive listener bound. It only
+// differs from 127.0.0.1:PositivePort when the server was started WithPorts.
+func (s *Server) PositiveAddr() string {
+	if s == nil {
+		return ""
+	}
+	return s.positiveAddr
+}
+
+// NegativeAddr returns the host:port the negative listener bound.
+func (s *Server) NegativeAddr() string {
+	if s == nil {
+		return ""
+	}
+	return s.negativeAddr
+}
+
+// Store returns the entity store backing the stateful collections, or nil
+// when the server was started without any. Tests use it to seed entities,
+// assert on what the provider wrote, and Reset between cases.
+func (s *Server) Store() *Store {
+	if s == nil {
+		return nil
+	}
+	return s.store
+}
+
+// InjectFault adds a fault policy to both listeners and returns its ID for
+// RemoveFault. Faults stay active until removed or cleared, so tests should
+// defer ClearFaults.
+func (s *Server) InjectFault(f Fault) (string, error) {
+	if s == nil {
+		return "", fmt.Errorf("mockserver: server is not running")
+	}
+	return s.faults.add(f)
+}
+
+// RemoveFault removes one fault policy and reports whether it existed.
+func (s *Server) RemoveFault(id string) bool {
+	if s == nil {
+		return false
+	}
+	return s.faults.remove(id)
+}
+
+// ClearFaults removes every fault policy.
+func (s *Server) ClearFaults() {
+	if s == nil {
+		return
+	}
+	s.faults.clear()
+}
+
+// Faults returns the active fault policies with how often each has fired.
+func (s *Server) Faults() []FaultState {
+	if s == nil {
+		return nil
+	}
+	return s.faults.list()
+}
+
+// ExpireSessions invalidates every session token issued by LoginPath, so
+// the next request carrying one receives 401 — what a long-running client
+// sees when its Palette session times out.
+func (s *Server) ExpireSessions() {
+	if s == nil {
+		return
+	}
+	s.sessions.expireAll()
+}
+
+// LoginCount returns how many successful logins LoginPath has served.
+func (s *Server) LoginCount() int {
+	if s == nil {
+		return 0
+	}
+	return s.sessions.loginCount()
+}
+
+// Option customizes StartWith.
+type Option func(*options)
+
+type options struct {
+	store        *Store
+	collections  []Collection
+	faults       *faultInjector
+	sessions     *sessionStore
+	positivePort int
+	negativePort int
+}
+
+// WithPorts binds the listeners to the given ports instead of PositivePort
+// and NegativePort. Pass 0 to get an ephemeral port — packages other than
+// spectrocloud that start their own server must, since `go test ./...` runs
+// packages in parallel and the fixed ports belong to spectrocloud's
+// TestMain.
+func WithPorts(positive, negative int) Option {
+	return func(o *options) {
+		o.positivePort = positive
+		o.negativePort = negative
+	}
+}
+
+// WithStatefulCollections serves collections from an in-memory Store on the
+// positive listener, ahead of the static routes. Store misses still fall
+// through to the static routes.
+func WithStatefulCollections(collections ...Collection) Option {
+	return func(o *options) {
+		o.collections = append(o.collections, collections...)
+	}
+}
+
+// WithStore backs the stateful collections with store instead of a fresh
+// one, so a test can pre-seed entities before the server starts.
+func WithStore(store *Store) Option {
+	return func(o *options) {
+		o.store = store
+	}
 }
 
 // Stop shuts down both servers. It blocks until in-flight requests complete
@@ -80,47 +220,102 @@ func Start() (*Server, error) {
 	return StartWith(DefaultPositiveRoutes(), DefaultNegativeRoutes())
 }
 
+// StartStateful is Start with DefaultCollections served from an in-memory
+// Store, for tests that drive full Create → Read → Update → Delete flows.
+func StartStateful() (*Server, error) {
+	return StartWith(DefaultPositiveRoutes(), DefaultNegativeRoutes(),
+		WithStatefulCollections(DefaultCollections()...))
+}
+
 // StartWith is Start with caller-supplied route slices. Exposed so tests
 // that want to override behavior (e.g. verify a Handler-based dynamic
 // response) can layer routes without editing the aggregation in init().
-func StartWith(positive, negative []routes.Route) (*Server, error) {
+func StartWith(positive, negative []routes.Route, opts ...Option) (*Server, error) {
+	o := options{positivePort: PositivePort, negativePort: NegativePort}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if len(o.collections) > 0 && o.store == nil {
+		o.store = NewStore()
+	}
+	o.faults = &faultInjector{}
+	o.sessions = newSessionStore()
+
 	cert, err := generateSelfSignedCert()
 	if err != nil {
 		return nil, fmt.Errorf("mockserver: generate cert: %w", err)
 	}
 
-	pos, err := listenAndServe(PositivePort, positive, cert)
+	pos, posAddr, err := listenAndServe(o.positivePort, newHandler(positive, o), cert)
 	if err != nil {
 		return nil, fmt.Errorf("mockserver: start positive server: %w", err)
 	}
 
-	neg, err := listenAndServe(NegativePort, negative, cert)
+	neg, negAddr, err := listenAndServe(o.negativePort, newHandler(negative, options{faults: o.faults, sessions: o.sessions}), cert)
 	if err != nil {
 		// Clean up the half we already started.
 		_ = pos.Close()
 		return nil, fmt.Errorf("mockserver: start negative server: %w", err)
 	}
 
-	return &Server{positive: pos, negative: neg}, nil
+	return &Server{
+		positive:     pos,
+		negative:     neg,
+		store:        o.store,
+		faults:       o.faults,
+		sessions:     o.sessions,
+		positiveAddr: posAddr,
+		negativeAddr: negAddr,
+	}, nil
 }
 
-// listenAndServe binds a TLS listener on 127.0.0.1:<port> and starts serving
-// in a goroutine. Returning after the bind (rather than after Serve) means
-// callers can rely on the port being ready for connections the moment Start
-// returns — no health-check retry loop required.
-func listenAndServe(port int, routeSet []routes.Route, cert tls.Certificate) (*http.Server, error) {
+// newHandler builds the router for one listener: the static routes, with
+// the stateful collections layered in front when configured, and the login
+// endpoint, fault injector and its admin endpoint in front of both.
+func newHandler(routeSet []routes.Route, o options) http.Handler {
+	auth := authMiddleware(o.sessions)
 	router := mux.NewRouter()
-	router.Use(apiKeyAuthMiddleware)
+	router.Use(auth)
 	registerRoutes(router, routeSet)
 
+	var handler http.Handler = router
+	if len(o.collections) > 0 {
+		handler = newStatefulRouter(o.store, o.collections, router, auth)
+	}
+	if o.faults == nil && o.sessions == nil {
+		return handler
+	}
+
+	front := mux.NewRouter()
+	if o.sessions != nil {
+		o.sessions.loginRoutes(front)
+	}
+	if o.faults != nil {
+		admin := front.PathPrefix(FaultAdminPath).Subrouter()
+		admin.Use(auth)
+		o.faults.adminRoutes(admin)
+		handler = o.faults.middleware(handler)
+	}
+	front.NotFoundHandler = handler
+	front.MethodNotAllowedHandler = handler
+	return front
+}
+
+// listenAndServe binds a TLS listener on 127.0.0.1:<port> and starts serving
+// in a goroutine. Returning after the bind (rather than after Serve) means
+// callers can rely on the port being ready for connections the moment Start
+// returns — no health-check retry loop required. The bound address is
+// returned alongside so port 0 callers learn which port they got.
+func listenAndServe(port int, handler http.Handler, cert tls.Certificate) (*http.Server, string, error) {
 	ln, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
+	addr := ln.Addr().String()
 
 	srv := &http.Server{
-		Addr:         fmt.Sprintf("127.0.0.1:%d", port),
-		Handler:      router,
+		Addr:         addr,
+		Handler:      handler,
 		ReadTimeout:  15 * time.Second,
 		WriteTimeout: 15 * time.Second,
 		IdleTimeout:  60 * time.Second,
@@ -134,11 +329,11 @@ func listenAndServe(port int, routeSet []routes.Route, cert tls.Certificate) (*h
 	go func() {
 		if err := srv.Serve(tlsLn); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			// The test binary has no useful place to surface this — log to stderr.
-			fmt.Printf("mockserver on :%d exited: %v\n", port, err)
+			fmt.Printf("mockserver on %s exited: %v\n", addr, err)
 		}
 	}()
 
-	return srv, nil
+	return srv, addr, nil
 }
 
 // apiKeyAuthMiddleware mirrors the check the previous standalone binary
@@ -208,6 +403,7 @@ func DefaultPositiveRoutes() []routes.Route {
 		routes.AksClusterRoutes,
 		routes.AzureClusterRoutes,
 		routes.EksClusterRoutes,
+		routes.ClusterKubeconfigRoutes,
 		routes.ClusterRoutes,
 		routes.CustomCloudClusterRoutes,
 		routes.AwsClusterRoutes,
@@ -225,6 +421,9 @@ func DefaultPositiveRoutes() []routes.Route {
 		routes.TeamRoutes,
 		routes.ApplicationRoutes,
 		routes.BackupRoutes,
+		routes.ClusterBackupRoutes,
+		routes.ClusterScanRoutes,
+		routes.ClusterCertificatesRoutes,
 		routes.IPPoolRoutes,
 		routes.MacrosRoutes,
 		routes.WorkspaceRoutes,
@@ -0,0 +1,220 @@
+package mockserver
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spectrocloud/terraform-provider-spectrocloud/tests/mockApiServer/routes"
+)
+
+// Fixture is one recorded exchange in the on-disk fixture format. Only the
+// response is kept: request bodies routinely carry cloud credentials, and
+// replay matches on method + path alone, the same as a static Route.
+type Fixture struct {
+	Method     string          `json:"method"`
+	Path       string          `json:"path"`
+	StatusCode int             `json:"status_code"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+}
+
+// Route converts the fixture into a static route the server can replay.
+func (f Fixture) Route() routes.Route {
+	var payload interface{}
+	if len(f.Payload) > 0 {
+		payload = f.Payload
+	}
+	return routes.Route{
+		Method: f.Method,
+		Path:   f.Path,
+		Response: routes.ResponseData{
+			StatusCode: f.StatusCode,
+			Payload:    payload,
+		},
+	}
+}
+
+// LoadFixtures reads a fixture file written by Recorder.WriteFixtures and
+// returns it as routes. Prepend the result to DefaultPositiveRoutes() when
+// calling StartWith — the router takes the first match, so recorded
+// responses win over the hand-written fixtures for the same path.
+func LoadFixtures(path string) ([]routes.Route, error) {
+	raw, err := os.ReadFile(path) // #nosec G304 -- test tooling reads a caller-chosen fixture file
+	if err != nil {
+		return nil, fmt.Errorf("mockserver: read fixtures: %w", err)
+	}
+	var fixtures []Fixture
+	if err := json.Unmarshal(raw, &fixtures); err != nil {
+		return nil, fmt.Errorf("mockserver: decode fixtures %s: %w", path, err)
+	}
+	out := make([]routes.Route, 0, len(fixtures))
+	for _, f := range fixtures {
+		out = append(out, f.Route())
+	}
+	return out, nil
+}
+
+// Recorder is a recording reverse proxy: it listens where the mock normally
+// would, forwards every request to a real Palette endpoint unchanged
+// (including the caller's ApiKey header), and keeps the last JSON response
+// seen for each method + path so it can be written out as fixtures.
+//
+// Point the provider at the recorder with the real API key, run the flow
+// once, then Stop and WriteFixtures. Responses are stored verbatim, so
+// review the file before committing it — Palette does echo some secrets
+// back (e.g. registry credentials on GET).
+type Recorder struct {
+	server *http.Server
+
+	mu       sync.Mutex
+	fixtures map[string]Fixture
+}
+
+// StartRecorder starts a recording proxy on PositivePort that forwards to
+// upstream (a Palette host such as "api.spectrocloud.com", or a full
+// https:// URL). The mock's own ApiKey check is not applied.
+func StartRecorder(upstream string) (*Recorder, error) {
+	target, err := parseUpstream(upstream)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := generateSelfSignedCert()
+	if err != nil {
+		return nil, fmt.Errorf("mockserver: generate cert: %w", err)
+	}
+
+	rec := &Recorder{fixtures: map[string]Fixture{}}
+
+	ln, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", PositivePort))
+	if err != nil {
+		return nil, fmt.Errorf("mockserver: start recorder: %w", err)
+	}
+
+	rec.server = &http.Server{
+		Addr:              fmt.Sprintf("127.0.0.1:%d", PositivePort),
+		Handler:           rec.proxy(target),
+		ReadHeaderTimeout: 15 * time.Second,
+		TLSConfig: &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		},
+	}
+
+	tlsLn := tls.NewListener(ln, rec.server.TLSConfig)
+	go func() {
+		if err := rec.server.Serve(tlsLn); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Printf("mockserver recorder on :%d exited: %v\n", PositivePort, err)
+		}
+	}()
+
+	return rec, nil
+}
+
+// Stop closes the recording listener. Fixtures captured so far stay
+// available.
+func (rec *Recorder) Stop() {
+	if rec == nil || rec.server == nil {
+		return
+	}
+	_ = rec.server.Close()
+}
+
+// Fixtures returns the captured exchanges sorted by path, then method, so
+// the written file diffs cleanly between recording runs.
+func (rec *Recorder) Fixtures() []Fixture {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	out := make([]Fixture, 0, len(rec.fixtures))
+	for _, f := range rec.fixtures {
+		out = append(out, f)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Path != out[j].Path {
+			return out[i].Path < out[j].Path
+		}
+		return out[i].Method < out[j].Method
+	})
+	return out
+}
+
+// WriteFixtures writes the captured exchanges to path in the format
+// LoadFixtures reads.
+func (rec *Recorder) WriteFixtures(path string) error {
+	raw, err := json.MarshalIndent(rec.Fixtures(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("mockserver: encode fixtures: %w", err)
+	}
+	if err := os.WriteFile(path, append(raw, '\n'), 0o600); err != nil {
+		return fmt.Errorf("mockserver: write fixtures: %w", err)
+	}
+	return nil
+}
+
+func (rec *Recorder) proxy(target *url.URL) http.Handler {
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	director := proxy.Director
+	proxy.Director = func(r *http.Request) {
+		director(r)
+		r.Host = target.Host
+	}
+	proxy.ModifyResponse = func(res *http.Response) error {
+		rec.capture(res)
+		return nil
+	}
+	return proxy
+}
+
+// capture records res when it carries JSON (or nothing at all). The body is
+// buffered and handed back to the proxy untouched.
+func (rec *Recorder) capture(res *http.Response) {
+	raw, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(raw))
+	if err != nil {
+		return
+	}
+
+	f := Fixture{
+		Method:     res.Request.Method,
+		Path:       res.Request.URL.Path,
+		StatusCode: res.StatusCode,
+	}
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 {
+		if !json.Valid(trimmed) {
+			return
+		}
+		f.Payload = json.RawMessage(trimmed)
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.fixtures[f.Method+" "+f.Path] = f
+}
+
+// parseUpstream accepts either a bare host (the same form the provider's
+// `host` argument takes) or a full URL.
+func parseUpstream(upstream string) (*url.URL, error) {
+	if upstream == "" {
+		return nil, errors.New("mockserver: recorder upstream is required")
+	}
+	u, err := url.Parse(upstream)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		u, err = url.Parse("https://" + upstream)
+		if err != nil {
+			return nil, fmt.Errorf("mockserver: parse upstream %q: %w", upstream, err)
+		}
+	}
+	return u, nil
+}
@@ -0,0 +1,111 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/mux"
+)
+
+// JWT is a fixed bearer token the mock always accepts in the Authorization
+// header, standing in for a token minted by an external SSO broker.
+const JWT = "mock-jwt-token"
+
+// Username and Password are the local credentials LoginPath accepts. Each
+// successful login mints a fresh session token that stays valid until
+// Server.ExpireSessions.
+const (
+	Username = "admin@spectrocloud.com"
+	Password = "mock-password"
+)
+
+// LoginPath is Palette's username/password login endpoint. It is the only
+// route served without credentials.
+const LoginPath = "/v1/auth/authenticate"
+
+// sessionStore tracks the session tokens LoginPath has handed out, shared by
+// both listeners like the fault injector.
+type sessionStore struct {
+	mu     sync.Mutex
+	tokens map[string]bool
+	logins int
+}
+
+func newSessionStore() *sessionStore {
+	return &sessionStore{tokens: map[string]bool{}}
+}
+
+func (s *sessionStore) issue() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.logins++
+	token := "mock-session-" + generateUID()
+	s.tokens[token] = true
+	return token
+}
+
+func (s *sessionStore) valid(token string) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokens[token]
+}
+
+func (s *sessionStore) expireAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = map[string]bool{}
+}
+
+func (s *sessionStore) loginCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.logins
+}
+
+// loginRoutes registers LoginPath on router. The body is a V1AuthLogin and
+// the response a V1UserToken, as in Palette.
+func (s *sessionStore) loginRoutes(router *mux.Router) {
+	router.HandleFunc(LoginPath, func(w http.ResponseWriter, r *http.Request) {
+		var in struct {
+			EmailID  string `json:"emailId"`
+			Password string `json:"password"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil || in.EmailID != Username || in.Password != Password {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{
+				"code":    "InvalidCredentials",
+				"message": "Invalid credentials",
+			})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"Authorization": s.issue(),
+			"isMfa":         false,
+		})
+	}).Methods(http.MethodPost)
+}
+
+// authMiddleware accepts the fixed ApiKey, the fixed JWT, or a live session
+// token. A missing or wrong ApiKey gets 403, as it always has; a stale
+// Authorization token gets 401 so clients know to log in again.
+func authMiddleware(sessions *sessionStore) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if token := r.Header.Get("Authorization"); token != "" && r.Header.Get("ApiKey") == "" {
+				if token != JWT && !sessions.valid(token) {
+					writeJSON(w, http.StatusUnauthorized, map[string]string{
+						"code":    "Unauthorized",
+						"message": "Authorization token is invalid or expired",
+					})
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+			apiKeyAuthMiddleware(next).ServeHTTP(w, r)
+		})
+	}
+}
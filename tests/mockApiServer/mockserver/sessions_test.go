@@ -0,0 +1,49 @@
+package mockserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionLoginAndExpiry(t *testing.T) {
+	sessions := newSessionStore()
+	h := newHandler(DefaultPositiveRoutes(), options{sessions: sessions})
+
+	do := func(method, path, authorization, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do(http.MethodPost, LoginPath, "", `{"emailId":"`+Username+`","password":"wrong"}`)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = do(http.MethodPost, LoginPath, "", `{"emailId":"`+Username+`","password":"`+Password+`"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	token, _ := decodeObject(t, rec)["Authorization"].(string)
+	require.NotEmpty(t, token)
+	assert.Equal(t, 1, sessions.loginCount())
+
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, "/v1/workspaces/ws-1", token, "").Code)
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, "/v1/workspaces/ws-1", JWT, "").Code)
+
+	sessions.expireAll()
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodGet, "/v1/workspaces/ws-1", token, "").Code)
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, "/v1/workspaces/ws-1", JWT, "").Code, "the fixed JWT never expires")
+	assert.Equal(t, http.StatusForbidden, do(http.MethodGet, "/v1/workspaces/ws-1", "", "").Code)
+}
+
+func TestSessionControlsOnNilServer(t *testing.T) {
+	var s *Server
+	s.ExpireSessions()
+	assert.Zero(t, s.LoginCount())
+}
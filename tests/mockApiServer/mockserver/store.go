@@ -0,0 +1,384 @@
+package mockserver
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/mux"
+)
+
+// Store is the in-memory entity store behind the stateful mode. Entities are
+// kept as decoded JSON objects keyed by collection name, then UID, so the
+// store does not need to know any Palette model type — whatever the provider
+// POSTs is what a later GET returns (after the collection's Materialize hook
+// has filled in server-owned fields).
+//
+// All methods are safe for concurrent use and hand out deep copies, so a test
+// inspecting an entity can't race the server mutating it.
+type Store struct {
+	mu          sync.RWMutex
+	collections map[string]*storeCollection
+}
+
+// storeCollection keeps insertion order alongside the entity map so List
+// returns a stable ordering across calls.
+type storeCollection struct {
+	order    []string
+	entities map[string]map[string]interface{}
+}
+
+// NewStore returns an empty Store.
+func NewStore() *Store {
+	return &Store{collections: map[string]*storeCollection{}}
+}
+
+// Create stores entity under a freshly generated UID, writes that UID into
+// metadata.uid and returns it.
+func (s *Store) Create(collection string, entity map[string]interface{}) string {
+	uid := generateUID()
+	s.Put(collection, uid, entity)
+	return uid
+}
+
+// Put stores entity under uid, replacing any previous value. Tests use it to
+// seed the store with a known UID before driving the provider.
+func (s *Store) Put(collection, uid string, entity map[string]interface{}) {
+	entity = deepCopy(entity)
+	setUID(entity, uid)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := s.collection(collection)
+	if _, ok := c.entities[uid]; !ok {
+		c.order = append(c.order, uid)
+	}
+	c.entities[uid] = entity
+}
+
+// Get returns a copy of the entity stored under uid.
+func (s *Store) Get(collection, uid string) (map[string]interface{}, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.collections[collection]
+	if !ok {
+		return nil, false
+	}
+	entity, ok := c.entities[uid]
+	if !ok {
+		return nil, false
+	}
+	return deepCopy(entity), true
+}
+
+// List returns copies of every entity in the collection, in insertion order.
+func (s *Store) List(collection string) []map[string]interface{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.collections[collection]
+	if !ok {
+		return nil
+	}
+	out := make([]map[string]interface{}, 0, len(c.order))
+	for _, uid := range c.order {
+		out = append(out, deepCopy(c.entities[uid]))
+	}
+	return out
+}
+
+// Patch applies patch to the stored entity as an RFC 7386 JSON merge patch:
+// objects merge recursively, null deletes a key and anything else replaces.
+// It reports false when uid is not stored.
+func (s *Store) Patch(collection, uid string, patch map[string]interface{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.collections[collection]
+	if !ok {
+		return false
+	}
+	entity, ok := c.entities[uid]
+	if !ok {
+		return false
+	}
+	mergePatch(entity, deepCopy(patch))
+	setUID(entity, uid)
+	return true
+}
+
+// Delete removes the entity stored under uid and reports whether it existed.
+func (s *Store) Delete(collection, uid string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.collections[collection]
+	if !ok {
+		return false
+	}
+	if _, ok := c.entities[uid]; !ok {
+		return false
+	}
+	delete(c.entities, uid)
+	for i, id := range c.order {
+		if id == uid {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// Reset drops every stored entity. Tests sharing one server call it between
+// cases so state from one flow doesn't leak into the next.
+func (s *Store) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.collections = map[string]*storeCollection{}
+}
+
+// collection returns the named collection, creating it on first use. Callers
+// must hold s.mu for writing.
+func (s *Store) collection(name string) *storeCollection {
+	c, ok := s.collections[name]
+	if !ok {
+		c = &storeCollection{entities: map[string]map[string]interface{}{}}
+		s.collections[name] = c
+	}
+	return c
+}
+
+// Collection describes one REST collection served from the Store.
+//
+// Items live under Path + "/{uid}" and answer GET, PUT, PATCH and DELETE.
+// POSTs to any of CreatePaths (Path when empty) persist a new entity and
+// reply 201 {"uid": ...}, the same shape Palette returns. A GET on Path lists
+// the stored entities as {"items": [...]}.
+//
+// Requests the store can't answer — an unknown UID, an empty collection on
+// list, or a body that isn't a JSON object — fall through to the static
+// routes, so the existing fixtures keep serving every UID the tests already
+// hard-code.
+type Collection struct {
+	// Name keys the collection inside the Store.
+	Name string
+	// Path is the collection endpoint, e.g. "/v1/workspaces".
+	Path string
+	// CreatePaths lists the endpoints that accept creates. Clusters need
+	// several because each cloud type has its own create endpoint.
+	CreatePaths []string
+	// Materialize fills in server-owned fields (status, refs, published
+	// templates) after a POST or PUT. r is the request that carried the
+	// entity; nil stores the body as sent.
+	Materialize func(r *http.Request, uid string, entity map[string]interface{})
+}
+
+func (c Collection) createPaths() []string {
+	if len(c.CreatePaths) == 0 {
+		return []string{c.Path}
+	}
+	return c.CreatePaths
+}
+
+// newStatefulRouter serves collections from store and hands everything else,
+// including store misses, to fallback. auth is the same credential check
+// the static routes apply.
+func newStatefulRouter(store *Store, collections []Collection, fallback http.Handler, auth mux.MiddlewareFunc) *mux.Router {
+	router := mux.NewRouter()
+	router.Use(auth)
+
+	for _, c := range collections {
+		h := &collectionHandler{store: store, collection: c, fallback: fallback}
+		for _, p := range c.createPaths() {
+			router.HandleFunc(p, h.create).Methods(http.MethodPost)
+		}
+		router.HandleFunc(c.Path, h.list).Methods(http.MethodGet)
+
+		item := c.Path + "/{uid}"
+		router.HandleFunc(item, h.get).Methods(http.MethodGet)
+		router.HandleFunc(item, h.replace).Methods(http.MethodPut)
+		router.HandleFunc(item, h.patch).Methods(http.MethodPatch)
+		router.HandleFunc(item, h.delete).Methods(http.MethodDelete)
+	}
+
+	router.NotFoundHandler = fallback
+	router.MethodNotAllowedHandler = fallback
+	return router
+}
+
+type collectionHandler struct {
+	store      *Store
+	collection Collection
+	fallback   http.Handler
+}
+
+func (h *collectionHandler) create(w http.ResponseWriter, r *http.Request) {
+	entity, ok := readEntity(r)
+	if !ok {
+		h.fallback.ServeHTTP(w, r)
+		return
+	}
+	uid := generateUID()
+	h.materialize(r, uid, entity)
+	h.store.Put(h.collection.Name, uid, entity)
+	writeJSON(w, http.StatusCreated, map[string]string{"uid": uid})
+}
+
+func (h *collectionHandler) list(w http.ResponseWriter, r *http.Request) {
+	items := h.store.List(h.collection.Name)
+	if len(items) == 0 {
+		h.fallback.ServeHTTP(w, r)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"items": items})
+}
+
+func (h *collectionHandler) get(w http.ResponseWriter, r *http.Request) {
+	entity, ok := h.store.Get(h.collection.Name, mux.Vars(r)["uid"])
+	if !ok {
+		h.fallback.ServeHTTP(w, r)
+		return
+	}
+	writeJSON(w, http.StatusOK, entity)
+}
+
+// replace swaps in the PUT body but keeps the stored status — Palette
+// ignores client-supplied status on update, and dropping it here would send
+// every waiter back to an empty state after an update.
+func (h *collectionHandler) replace(w http.ResponseWriter, r *http.Request) {
+	uid := mux.Vars(r)["uid"]
+	existing, ok := h.store.Get(h.collection.Name, uid)
+	if !ok {
+		h.fallback.ServeHTTP(w, r)
+		return
+	}
+	entity, ok := readEntity(r)
+	if !ok {
+		h.fallback.ServeHTTP(w, r)
+		return
+	}
+	if status, ok := existing["status"]; ok {
+		entity["status"] = status
+	}
+	h.materialize(r, uid, entity)
+	h.store.Put(h.collection.Name, uid, entity)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *collectionHandler) patch(w http.ResponseWriter, r *http.Request) {
+	uid := mux.Vars(r)["uid"]
+	if _, ok := h.store.Get(h.collection.Name, uid); !ok {
+		h.fallback.ServeHTTP(w, r)
+		return
+	}
+	patch, ok := readEntity(r)
+	if !ok {
+		h.fallback.ServeHTTP(w, r)
+		return
+	}
+	h.store.Patch(h.collection.Name, uid, patch)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *collectionHandler) delete(w http.ResponseWriter, r *http.Request) {
+	if !h.store.Delete(h.collection.Name, mux.Vars(r)["uid"]) {
+		h.fallback.ServeHTTP(w, r)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *collectionHandler) materialize(r *http.Request, uid string, entity map[string]interface{}) {
+	if h.collection.Materialize != nil {
+		h.collection.Materialize(r, uid, entity)
+	}
+}
+
+// readEntity decodes the request body as a JSON object. The body is restored
+// before returning so a fallback handler can still read it when decoding
+// fails.
+func readEntity(r *http.Request) (map[string]interface{}, bool) {
+	if r.Body == nil {
+		return nil, false
+	}
+	raw, err := io.ReadAll(r.Body)
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(raw))
+	if err != nil {
+		return nil, false
+	}
+	var entity map[string]interface{}
+	if err := json.Unmarshal(raw, &entity); err != nil || entity == nil {
+		return nil, false
+	}
+	return entity, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(payload)
+}
+
+// mergePatch applies patch onto dst following RFC 7386.
+func mergePatch(dst, patch map[string]interface{}) {
+	for k, v := range patch {
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+		if pm, ok := v.(map[string]interface{}); ok {
+			if dm, ok := dst[k].(map[string]interface{}); ok {
+				mergePatch(dm, pm)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+// setUID writes uid into entity.metadata.uid, creating metadata if needed.
+func setUID(entity map[string]interface{}, uid string) {
+	metadata, ok := entity["metadata"].(map[string]interface{})
+	if !ok {
+		metadata = map[string]interface{}{}
+		entity["metadata"] = metadata
+	}
+	metadata["uid"] = uid
+}
+
+// nestedMap returns entity[key] as an object, creating it if absent.
+func nestedMap(entity map[string]interface{}, key string) map[string]interface{} {
+	m, ok := entity[key].(map[string]interface{})
+	if !ok {
+		m = map[string]interface{}{}
+		entity[key] = m
+	}
+	return m
+}
+
+// deepCopy clones a decoded JSON object through a marshal round-trip. The
+// values only ever come from encoding/json, so the round-trip is lossless.
+func deepCopy(in map[string]interface{}) map[string]interface{} {
+	if in == nil {
+		return map[string]interface{}{}
+	}
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return map[string]interface{}{}
+	}
+	var out map[string]interface{}
+	_ = json.Unmarshal(raw, &out)
+	return out
+}
+
+// generateUID returns a 24-character hex UID, the same shape as Palette's
+// object IDs.
+func generateUID() string {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,183 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// These tests drive newHandler through httptest rather than Start: the
+// spectrocloud package's TestMain owns the fixed ports, and `go test ./...`
+// runs packages in parallel.
+
+func doJSON(t *testing.T, h http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	var req *http.Request
+	if body == "" {
+		req = httptest.NewRequest(method, path, nil)
+	} else {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+	}
+	req.Header.Set("ApiKey", APIKey)
+	req.Header.Set("ProjectUid", "testprojectuid")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeObject(t *testing.T, rec *httptest.ResponseRecorder) map[string]interface{} {
+	t.Helper()
+	var out map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &out))
+	return out
+}
+
+func TestStatefulCollectionLifecycle(t *testing.T) {
+	store := NewStore()
+	h := newHandler(DefaultPositiveRoutes(), options{store: store, collections: DefaultCollections()})
+
+	rec := doJSON(t, h, http.MethodPost, "/v1/workspaces", `{"metadata":{"name":"ws-1","labels":{"env":"dev"}}}`)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	uid, _ := decodeObject(t, rec)["uid"].(string)
+	require.Len(t, uid, 24)
+
+	rec = doJSON(t, h, http.MethodGet, "/v1/workspaces/"+uid, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	metadata := decodeObject(t, rec)["metadata"].(map[string]interface{})
+	assert.Equal(t, "ws-1", metadata["name"])
+	assert.Equal(t, uid, metadata["uid"])
+
+	rec = doJSON(t, h, http.MethodPatch, "/v1/workspaces/"+uid, `{"metadata":{"labels":{"env":null,"team":"a"}}}`)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	stored, ok := store.Get("workspaces", uid)
+	require.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"team": "a"}, stored["metadata"].(map[string]interface{})["labels"])
+
+	rec = doJSON(t, h, http.MethodPut, "/v1/workspaces/"+uid, `{"metadata":{"name":"ws-renamed"}}`)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	stored, _ = store.Get("workspaces", uid)
+	assert.Equal(t, "ws-renamed", stored["metadata"].(map[string]interface{})["name"])
+	assert.Equal(t, uid, stored["metadata"].(map[string]interface{})["uid"])
+
+	rec = doJSON(t, h, http.MethodDelete, "/v1/workspaces/"+uid, "")
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	_, ok = store.Get("workspaces", uid)
+	assert.False(t, ok)
+}
+
+func TestStatefulMissFallsThroughToStaticRoutes(t *testing.T) {
+	h := newHandler(DefaultPositiveRoutes(), options{store: NewStore(), collections: DefaultCollections()})
+
+	// Unknown UID: the static workspace fixture answers.
+	rec := doJSON(t, h, http.MethodGet, "/v1/workspaces/not-in-store", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "Default", decodeObject(t, rec)["metadata"].(map[string]interface{})["name"])
+
+	// Sub-resources are never stateful.
+	rec = doJSON(t, h, http.MethodGet, "/v1/workspaces/not-in-store/backup", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// The ApiKey check still applies to routes the store owns.
+	req := httptest.NewRequest(http.MethodGet, "/v1/workspaces/anything", nil)
+	forbidden := httptest.NewRecorder()
+	h.ServeHTTP(forbidden, req)
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+}
+
+func TestStatefulClusterAndProfileMaterialize(t *testing.T) {
+	store := NewStore()
+	h := newHandler(DefaultPositiveRoutes(), options{store: store, collections: DefaultCollections()})
+
+	rec := doJSON(t, h, http.MethodPost, "/v1/spectroclusters/aws", `{"metadata":{"name":"c1"},"spec":{}}`)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	uid := decodeObject(t, rec)["uid"].(string)
+
+	cluster, ok := store.Get("spectroclusters", uid)
+	require.True(t, ok)
+	spec := cluster["spec"].(map[string]interface{})
+	assert.Equal(t, "aws", spec["cloudType"])
+	assert.Equal(t, uid, spec["cloudConfigRef"].(map[string]interface{})["uid"])
+	assert.Equal(t, "Running", cluster["status"].(map[string]interface{})["state"])
+	assert.Equal(t, "project", cluster["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})["scope"])
+
+	rec = doJSON(t, h, http.MethodPost, "/v1/clusterprofiles",
+		`{"metadata":{"name":"p1"},"spec":{"version":"1.0.0","template":{"type":"cluster","packs":[{"name":"k8s"}]}}}`)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	uid = decodeObject(t, rec)["uid"].(string)
+
+	rec = doJSON(t, h, http.MethodGet, "/v1/clusterprofiles/"+uid, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	profile := decodeObject(t, rec)
+	published := profile["spec"].(map[string]interface{})["published"].(map[string]interface{})
+	assert.Equal(t, "1.0.0", published["profileVersion"])
+	assert.Equal(t, "p1", published["name"])
+	assert.Equal(t, true, profile["status"].(map[string]interface{})["isPublished"])
+}
+
+func TestStatefulListFallsThroughWhenEmpty(t *testing.T) {
+	store := NewStore()
+	h := newHandler(nil, options{store: store, collections: []Collection{{Name: "things", Path: "/v1/things"}}})
+
+	rec := doJSON(t, h, http.MethodGet, "/v1/things", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	store.Put("things", "seeded", map[string]interface{}{"spec": map[string]interface{}{"n": 1}})
+	rec = doJSON(t, h, http.MethodGet, "/v1/things", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	items := decodeObject(t, rec)["items"].([]interface{})
+	require.Len(t, items, 1)
+	assert.Equal(t, "seeded", items[0].(map[string]interface{})["metadata"].(map[string]interface{})["uid"])
+
+	store.Reset()
+	assert.Empty(t, store.List("things"))
+}
+
+func TestRecorderCapturesAndReplays(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/users/info":
+			writeJSON(w, http.StatusOK, map[string]string{"name": "recorded"})
+		case "/v1/assets/blob":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write([]byte("not json"))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer upstream.Close()
+
+	target, err := url.Parse(upstream.URL)
+	require.NoError(t, err)
+	rec := &Recorder{fixtures: map[string]Fixture{}}
+	proxy := rec.proxy(target)
+
+	for _, p := range []string{"/v1/users/info", "/v1/assets/blob", "/v1/things/1"} {
+		res := httptest.NewRecorder()
+		proxy.ServeHTTP(res, httptest.NewRequest(http.MethodGet, p, nil))
+		require.Less(t, res.Code, 300)
+	}
+
+	fixtures := rec.Fixtures()
+	require.Len(t, fixtures, 2, "non-JSON bodies are not recorded")
+	assert.Equal(t, "/v1/things/1", fixtures[0].Path)
+	assert.Equal(t, http.StatusNoContent, fixtures[0].StatusCode)
+	assert.Equal(t, "/v1/users/info", fixtures[1].Path)
+
+	path := filepath.Join(t.TempDir(), "fixtures.json")
+	require.NoError(t, rec.WriteFixtures(path))
+	loaded, err := LoadFixtures(path)
+	require.NoError(t, err)
+	require.Len(t, loaded, 2)
+
+	h := newHandler(loaded, options{})
+	res := doJSON(t, h, http.MethodGet, "/v1/users/info", "")
+	require.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, "recorded", decodeObject(t, res)["name"])
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
 	v1 "github.com/spectrocloud/palette-sdk-go/api/client/version1"
@@ -61,6 +63,19 @@ func clusterFixtureFor(uid string) (interface{}, int) {
 		c.Status = nil
 		return c, http.StatusOK
 
+	case clusterUpgradingOnceUID:
+		// Upgrading on the first read, then back to Running: a cluster
+		// applying an update, for the update waits.
+		c := getMockSpectroCluster()
+		c.Metadata.UID = clusterUpgradingOnceUID
+		clusterUpgradingOnce.Lock()
+		if !clusterUpgradingOnce.read {
+			c.Status.State = "Upgrading"
+		}
+		clusterUpgradingOnce.read = true
+		clusterUpgradingOnce.Unlock()
+		return c, http.StatusOK
+
 	case "cluster-uid-provisioning":
 		c := getMockSpectroCluster()
 		c.Status.State = "Provisioning"
@@ -74,6 +89,34 @@ func clusterFixtureFor(uid string) (interface{}, int) {
 		c.Status.State = "Running"
 		return c, http.StatusOK
 
+	case clusterFailedUID:
+		// Drives the failure report attached to failed cluster waits:
+		// unmet conditions and a pack that has not come up.
+		c := getMockSpectroCluster()
+		c.Metadata.UID = clusterFailedUID
+		c.Status.State = "Provisioning"
+		c.Status.Conditions = []*models.V1ClusterCondition{
+			{Type: strPtr("ControlPlaneReady"), Status: strPtr("False"), Reason: "WaitingForNodes", Message: "0 of 3 control plane nodes are ready"},
+			{Type: strPtr("InfrastructureReady"), Status: strPtr("True")},
+		}
+		c.Status.Packs = []*models.V1ClusterPackStatus{
+			{Name: "csi-aws-ebs", Condition: &models.V1ClusterCondition{Status: strPtr("False"), Message: "chart install failed"}},
+			{Name: "cni-calico", Condition: &models.V1ClusterCondition{Status: strPtr("True")}},
+		}
+		return c, http.StatusOK
+
+	case clusterProfileDiffClusterUID:
+		// Runs version 1.0.0 of test-cluster-profile-1; compared with
+		// clusterProfileDiffUID by the profile diff tests.
+		c := getMockSpectroCluster()
+		c.Spec.ClusterProfileTemplates[0].ProfileVersion = "1.0.0"
+		c.Spec.ClusterProfileTemplates[0].Packs = []*models.V1PackRef{
+			{Name: strPtr("k8"), Tag: "1.27.0", Values: "image: k8s:1.27\nreplicas: 1"},
+			{Name: strPtr("cni-calico"), Tag: "3.26.0", Values: "mode: vxlan"},
+			{Name: strPtr("custom-manifests"), Type: "manifest", Manifests: []*models.V1ObjectReference{{Name: "test-manifest-1"}}},
+		}
+		return c, http.StatusOK
+
 	case "cluster-uid-running-unhealthy":
 		c := getMockSpectroCluster()
 		c.Status.State = "Running"
@@ -219,6 +262,19 @@ func clusterFixtureFor(uid string) (interface{}, int) {
 		}
 		return c, http.StatusOK
 
+	case clusterCertificatesFailedUID:
+		// Running-Healthy, with a failed certificate condition: drives the
+		// failure branch of resourceClusterCertificatesRenewalRefreshFunc.
+		c := getMockSpectroCluster()
+		c.Status.Conditions = []*models.V1ClusterCondition{
+			{
+				Type:    strPtr("CertificatesRenewed"),
+				Status:  strPtr("False"),
+				Message: "kubeadm certs renew failed on cp-0",
+			},
+		}
+		return c, http.StatusOK
+
 	case "cluster-uid-overview-error", "cluster-uid-overview-missing-health":
 		// GetCluster must still succeed for these — only the paired
 		// overviewHandler branch differs. See overviewHandler below.
@@ -231,6 +287,21 @@ func clusterFixtureFor(uid string) (interface{}, int) {
 	}
 }
 
+// clusterUpgradingOnceUID is a cluster that reports Upgrading once.
+const clusterUpgradingOnceUID = "cluster-uid-upgrading-once"
+
+var clusterUpgradingOnce = struct {
+	sync.Mutex
+	read bool
+}{}
+
+// clusterFailedUID is a cluster stuck provisioning, with unmet conditions,
+// unhealthy machine pools and nodes, a failed pack and events explaining
+// why. See clusterEventsHandler.
+const clusterFailedUID = "cluster-uid-provisioning-failed"
+
+const clusterProfileDiffClusterUID = "cluster-uid-profile-diff"
+
 // clusterVariablesPatchErrorUID drives the UpdateClusterProfileVariableInCluster error
 // branch — any other UID gets the original blanket 204 success.
 const clusterVariablesPatchErrorUID = "cluster-uid-variables-patch-error"
@@ -247,6 +318,32 @@ func clusterVariablesPatchHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 // overviewHandler serves GET /v1/dashboard/spectroclusters/{uid}/overview.
+// clusterEventsHandler serves GET /v1/events/components/{objectKind}/{objectUid}.
+// Only clusterFailedUID has events; every other object has none.
+func clusterEventsHandler(w http.ResponseWriter, r *http.Request) {
+	events := &models.V1Events{Items: []*models.V1Event{}}
+	if mux.Vars(r)["objectUid"] == clusterFailedUID {
+		events.Items = []*models.V1Event{
+			mockClusterEvent("Normal", "Provisioning", "Cluster provisioning started", "2026-01-01T10:00:00Z"),
+			mockClusterEvent("Warning", "PackInstallFailed", "csi-aws-ebs: chart install failed", "2026-01-01T10:05:00Z"),
+			mockClusterEvent("Warning", "NodeNotReady", "cp-pool-node-1 is not ready", "2026-01-01T10:10:00Z"),
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(events)
+}
+
+func mockClusterEvent(severity, reason, message, created string) *models.V1Event {
+	ts, _ := time.Parse(time.RFC3339, created)
+	return &models.V1Event{
+		Metadata: &models.V1ObjectMeta{CreationTimestamp: models.V1Time(ts)},
+		Severity: severity,
+		Reason:   reason,
+		Message:  message,
+	}
+}
+
 // GetClusterOverview is called by resourceClusterStateRefreshFunc after
 // Status.State == "Running" to determine whether to append "-Healthy" to
 // the state string. Dispatch on UID so tests can drive both branches.
@@ -268,6 +365,31 @@ func overviewHandler(w http.ResponseWriter, r *http.Request) {
 	switch uid {
 	case "cluster-uid-running-unhealthy":
 		summary.Status.Health = &models.V1SpectroClusterHealthStatus{State: "Unhealthy"}
+	case clusterFailedUID:
+		summary.Spec = &models.V1SpectroClusterUIDSummarySpec{
+			CloudConfig: &models.V1CloudConfigMeta{
+				MachinePools: []*models.V1MachinePoolMeta{
+					{IsControlPlane: true, Labels: []string{"control-plane"}, Size: 3, Healthy: 1},
+					{Labels: []string{"worker"}, Size: 2, Healthy: 2},
+					{Size: 2},
+				},
+			},
+		}
+		summary.Status.Health = &models.V1SpectroClusterHealthStatus{
+			State: "UnHealthy",
+			Conditions: []*models.V1SpectroClusterHealthCondition{
+				{
+					Type:          "MachinePool",
+					Message:       "2 of 3 machines are not ready",
+					RelatedObject: &models.V1RelatedObject{Kind: "MachinePool", Name: "cp-pool"},
+				},
+				{
+					Type:          "Node",
+					Message:       "kubelet stopped posting node status",
+					RelatedObject: &models.V1RelatedObject{Kind: "Node", Name: "cp-pool-node-1"},
+				},
+			},
+		}
 	case "cluster-uid-overview-missing-health":
 		// Health left nil — drives resourceClusterBrownfieldRead's
 		// "health info missing" branch (health_status → "Unknown").
@@ -314,6 +436,9 @@ func clusterFeatureBackupHandler(w http.ResponseWriter, r *http.Request) {
 				},
 			},
 		})
+	case clusterBackupUID:
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(clusterBackupFixture())
 	default:
 		w.WriteHeader(http.StatusOK)
 		_ = json.NewEncoder(w).Encode(&models.V1ClusterBackup{Spec: &models.V1ClusterBackupSpec{}})
@@ -535,6 +660,11 @@ func ClusterRoutes() []Route {
 			Path:    "/v1/dashboard/spectroclusters/{uid}/overview",
 			Handler: overviewHandler,
 		},
+		{
+			Method:  "GET",
+			Path:    "/v1/events/components/{objectKind}/{objectUid}",
+			Handler: clusterEventsHandler,
+		},
 		{
 			Method: "GET",
 			Path:   "/v1/spectroclusters/{uid}/assets/kubeconfig",
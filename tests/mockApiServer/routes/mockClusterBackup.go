@@ -0,0 +1,243 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+)
+
+// On-demand cluster backups and restores, used by the
+// spectrocloud_cluster_backup and spectrocloud_cluster_restore tests.
+// clusterBackupUID has two on-demand backups: clusterBackupName, which
+// completed, and clusterBackupFailedName, which failed. Backups and restores
+// of clusterBackupFailedName are the ones that fail, and those of
+// clusterBackupLateName are left out of the first listing after they are
+// created. It also has two scheduled backups prefixed "daily-app", for the
+// spectrocloud_cluster_backups tests.
+const (
+	clusterBackupUID               = "cluster-uid-backup"
+	clusterBackupName              = "pre-upgrade"
+	clusterBackupRequestUID        = "backup-request-uid"
+	clusterBackupFailedName        = "broken"
+	clusterBackupFailedRequestUID  = "backup-request-failed-uid"
+	clusterRestoreRequestUID       = "restore-request-uid"
+	clusterRestoreFailedRequestUID = "restore-request-failed-uid"
+	clusterBackupLateName          = "listed-late"
+	clusterBackupLateRequestUID    = "backup-request-late-uid"
+	clusterRestoreLateRequestUID   = "restore-request-late-uid"
+)
+
+// clusterBackupLate counts how many times each created request of
+// clusterBackupLateName has been listed.
+var clusterBackupLate = struct {
+	sync.Mutex
+	listings map[string]int
+}{listings: map[string]int{}}
+
+// clusterBackupLateCreated records that the request uid was just created.
+func clusterBackupLateCreated(uid string) {
+	clusterBackupLate.Lock()
+	defer clusterBackupLate.Unlock()
+	clusterBackupLate.listings[uid] = 0
+}
+
+// clusterBackupLateListed reports whether a listing includes the request
+// uid: it must have been created and listed once already.
+func clusterBackupLateListed(uid string) bool {
+	clusterBackupLate.Lock()
+	defer clusterBackupLate.Unlock()
+	n, ok := clusterBackupLate.listings[uid]
+	if !ok {
+		return false
+	}
+	clusterBackupLate.listings[uid] = n + 1
+	return n > 0
+}
+
+var clusterBackupTime = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+// clusterBackupFixture is the backup feature of clusterBackupUID, served by
+// clusterFeatureBackupHandler.
+func clusterBackupFixture() *models.V1ClusterBackup {
+	location := &models.V1BackupLocationConfig{Name: "test-bsl", Type: "s3", UID: "test-bsl-location-id"}
+	backup := &models.V1ClusterBackup{
+		Spec: &models.V1ClusterBackupSpec{ClusterUID: clusterBackupUID},
+		Status: &models.V1ClusterBackupStatus{
+			ClusterBackupStatuses: []*models.V1ClusterBackupStatusMeta{
+				{
+					BackupRequestUID:     clusterBackupRequestUID,
+					BackupLocationConfig: location,
+					State:                "Completed",
+					BackupStatusMeta: []*models.V1BackupStatusMeta{
+						{
+							BackupName: clusterBackupName,
+							BackupState: &models.V1BackupState{
+								State:      "Completed",
+								BackupTime: models.V1Time(clusterBackupTime),
+							},
+							BackupedNamespaces: []string{"app", "default"},
+							ExpiryDate:         models.V1Time(clusterBackupTime.Add(720 * time.Hour)),
+						},
+					},
+				},
+				{
+					BackupRequestUID:     "backup-schedule-request-uid",
+					BackupLocationConfig: location,
+					State:                "Completed",
+					BackupStatusMeta: []*models.V1BackupStatusMeta{
+						{
+							BackupName: "daily-app-20260929",
+							BackupState: &models.V1BackupState{
+								State:      "Completed",
+								BackupTime: models.V1Time(clusterBackupTime.Add(-48 * time.Hour)),
+							},
+							BackupedNamespaces: []string{"app"},
+							ExpiryDate:         models.V1Time(clusterBackupTime.Add(-24 * time.Hour)),
+						},
+						{
+							BackupName: "daily-app-20260930",
+							BackupState: &models.V1BackupState{
+								State:      "PartiallyFailed",
+								Msg:        "1 volume snapshot failed",
+								BackupTime: models.V1Time(clusterBackupTime.Add(-24 * time.Hour)),
+							},
+							BackupedNamespaces: []string{"app"},
+							ExpiryDate:         models.V1Time(clusterBackupTime),
+						},
+					},
+				},
+				{
+					BackupRequestUID:     clusterBackupFailedRequestUID,
+					BackupLocationConfig: location,
+					State:                "Failed",
+					BackupStatusMeta: []*models.V1BackupStatusMeta{
+						{
+							BackupName: clusterBackupFailedName,
+							BackupState: &models.V1BackupState{
+								State: "Failed",
+								Msg:   "volume snapshot failed",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if clusterBackupLateListed(clusterBackupLateRequestUID) {
+		backup.Status.ClusterBackupStatuses = append(backup.Status.ClusterBackupStatuses, &models.V1ClusterBackupStatusMeta{
+			BackupRequestUID:     clusterBackupLateRequestUID,
+			BackupLocationConfig: location,
+			State:                "InProgress",
+		})
+	}
+	return backup
+}
+
+func clusterBackupOnDemandHandler(w http.ResponseWriter, r *http.Request) {
+	var config models.V1ClusterBackupConfig
+	_ = json.NewDecoder(r.Body).Decode(&config)
+	uid := clusterBackupRequestUID
+	switch config.BackupName {
+	case clusterBackupFailedName:
+		uid = clusterBackupFailedRequestUID
+	case clusterBackupLateName:
+		uid = clusterBackupLateRequestUID
+		clusterBackupLateCreated(uid)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(&models.V1UID{UID: strPtr(uid)})
+}
+
+func clusterRestoreOnDemandHandler(w http.ResponseWriter, r *http.Request) {
+	var config models.V1ClusterRestoreConfig
+	_ = json.NewDecoder(r.Body).Decode(&config)
+	uid := clusterRestoreRequestUID
+	if config.BackupName != nil {
+		switch *config.BackupName {
+		case clusterBackupFailedName:
+			uid = clusterRestoreFailedRequestUID
+		case clusterBackupLateName:
+			uid = clusterRestoreLateRequestUID
+			clusterBackupLateCreated(uid)
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(&models.V1UID{UID: strPtr(uid)})
+}
+
+// clusterRestoreHandler lists the restores into a cluster: the restores of
+// clusterBackupUID's backups, whatever the destination.
+func clusterRestoreHandler(w http.ResponseWriter, r *http.Request) {
+	source := &models.V1ResourceReference{Kind: "spectrocluster", UID: strPtr(clusterBackupUID)}
+	restore := &models.V1ClusterRestore{
+		Spec: &models.V1ClusterRestoreSpec{ClusterUID: mux.Vars(r)["uid"]},
+		Status: &models.V1ClusterRestoreStatus{
+			ClusterRestoreStatuses: []*models.V1ClusterRestoreStatusMeta{
+				{
+					BackupName:        clusterBackupName,
+					BackupRequestUID:  clusterBackupRequestUID,
+					RestoreRequestUID: clusterRestoreRequestUID,
+					SourceClusterRef:  source,
+					State:             "Completed",
+					RestoreStatusMeta: &models.V1RestoreStatusMeta{
+						IsSucceeded: true,
+						RestoreTime: models.V1Time(clusterBackupTime.Add(time.Hour)),
+					},
+				},
+				{
+					BackupName:        clusterBackupFailedName,
+					BackupRequestUID:  clusterBackupFailedRequestUID,
+					RestoreRequestUID: clusterRestoreFailedRequestUID,
+					SourceClusterRef:  source,
+					State:             "PartiallyFailed",
+					RestoreStatusMeta: &models.V1RestoreStatusMeta{Msg: "2 items failed to restore"},
+				},
+			},
+		},
+	}
+	if clusterBackupLateListed(clusterRestoreLateRequestUID) {
+		restore.Status.ClusterRestoreStatuses = append(restore.Status.ClusterRestoreStatuses, &models.V1ClusterRestoreStatusMeta{
+			BackupName:        clusterBackupLateName,
+			RestoreRequestUID: clusterRestoreLateRequestUID,
+			SourceClusterRef:  source,
+			State:             "InProgress",
+		})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(restore)
+}
+
+func ClusterBackupRoutes() []Route {
+	return []Route{
+		{
+			Method:  "POST",
+			Path:    "/v1/spectroclusters/{uid}/features/backup/onDemand",
+			Handler: clusterBackupOnDemandHandler,
+		},
+		{
+			Method: "DELETE",
+			Path:   "/v1/spectroclusters/{uid}/features/backup/{backupName}/request/{requestUid}",
+			Response: ResponseData{
+				StatusCode: http.StatusNoContent,
+				Payload:    nil,
+			},
+		},
+		{
+			Method:  "POST",
+			Path:    "/v1/spectroclusters/{uid}/features/restore/onDemand",
+			Handler: clusterRestoreOnDemandHandler,
+		},
+		{
+			Method:  "GET",
+			Path:    "/v1/spectroclusters/{uid}/features/restore",
+			Handler: clusterRestoreHandler,
+		},
+	}
+}
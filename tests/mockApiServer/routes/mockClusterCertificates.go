@@ -0,0 +1,113 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+)
+
+// Kubernetes certificates, used by the certificate renewal tests of the
+// cluster resources. The certificates of clusterCertificatesUID first expire
+// on clusterCertificatesExpiry, and a year later once renewed. Those of
+// clusterCertificatesUnreadUID too, but a renewal only shows from the second
+// read after it. Renewals of clusterCertificatesFailedUID fail, see
+// clusterFixtureFor. Other clusters don't report certificates.
+const (
+	clusterCertificatesUID       = "cluster-uid-certs"
+	clusterCertificatesUnreadUID = "cluster-uid-certs-unread"
+	clusterCertificatesFailedUID = "cluster-uid-certs-failed"
+)
+
+var clusterCertificatesExpiry = time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+
+var clusterCertificatesRenewed = struct {
+	sync.Mutex
+	uids    map[string]bool
+	pending map[string]bool
+}{uids: map[string]bool{}, pending: map[string]bool{}}
+
+func clusterCertificatesFixture(renewed bool) *models.V1MachineCertificates {
+	expiry := clusterCertificatesExpiry
+	if renewed {
+		expiry = expiry.AddDate(1, 0, 0)
+	}
+	caExpiry := models.V1Time(clusterCertificatesExpiry.AddDate(9, 0, 0))
+	machine := func(name string) *models.V1MachineCertificate {
+		return &models.V1MachineCertificate{
+			Name: name,
+			CertificateAuthorities: []*models.V1CertificateAuthority{
+				{
+					Name:   "etcd-ca",
+					Expiry: caExpiry,
+					Certificates: []*models.V1Certificate{
+						{Name: "etcd-server", Expiry: models.V1Time(expiry.Add(time.Hour))},
+					},
+				},
+				{
+					Name:   "ca",
+					Expiry: caExpiry,
+					Certificates: []*models.V1Certificate{
+						{Name: "apiserver-kubelet-client", Expiry: models.V1Time(expiry.Add(time.Hour))},
+						{Name: "apiserver", Expiry: models.V1Time(expiry)},
+					},
+				},
+			},
+		}
+	}
+	return &models.V1MachineCertificates{
+		MachineCertificates: []*models.V1MachineCertificate{machine("cp-1"), machine("cp-0")},
+	}
+}
+
+func clusterCertificatesHandler(w http.ResponseWriter, r *http.Request) {
+	uid := mux.Vars(r)["uid"]
+	w.Header().Set("Content-Type", "application/json")
+	if uid != clusterCertificatesUID && uid != clusterCertificatesUnreadUID && uid != clusterCertificatesFailedUID {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(getError("ResourceNotFound", "certificates not found"))
+		return
+	}
+	clusterCertificatesRenewed.Lock()
+	renewed := clusterCertificatesRenewed.uids[uid]
+	if clusterCertificatesRenewed.pending[uid] {
+		delete(clusterCertificatesRenewed.pending, uid)
+		clusterCertificatesRenewed.uids[uid] = true
+	}
+	clusterCertificatesRenewed.Unlock()
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(clusterCertificatesFixture(renewed))
+}
+
+// clusterCertificatesRenewHandler renews the certificates of
+// clusterCertificatesUID and clusterCertificatesUnreadUID. Renewals of
+// clusterCertificatesFailedUID leave its certificates as they are.
+func clusterCertificatesRenewHandler(w http.ResponseWriter, r *http.Request) {
+	clusterCertificatesRenewed.Lock()
+	switch uid := mux.Vars(r)["uid"]; uid {
+	case clusterCertificatesUID:
+		clusterCertificatesRenewed.uids[uid] = true
+	case clusterCertificatesUnreadUID:
+		clusterCertificatesRenewed.pending[uid] = true
+	}
+	clusterCertificatesRenewed.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func ClusterCertificatesRoutes() []Route {
+	return []Route{
+		{
+			Method:  "GET",
+			Path:    "/v1/spectroclusters/{uid}/k8certificates",
+			Handler: clusterCertificatesHandler,
+		},
+		{
+			Method:  "PATCH",
+			Path:    "/v1/spectroclusters/{uid}/k8certificates/renew",
+			Handler: clusterCertificatesRenewHandler,
+		},
+	}
+}
@@ -0,0 +1,150 @@
+package routes
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+)
+
+// Kubeconfigs of clusterKubeconfigUID, used by the
+// spectrocloud_cluster_kubeconfig tests. The proxy and admin kubeconfigs
+// authenticate with a client certificate, the direct kubeconfig with a token
+// and the client kubeconfig with OIDC. The certificates are generated on
+// startup with fixed expiry times.
+const clusterKubeconfigUID = "cluster-uid-kubeconfig"
+
+var (
+	clusterKubeconfigCAExpiry     = time.Date(2036, 10, 1, 0, 0, 0, 0, time.UTC)
+	clusterKubeconfigClientExpiry = time.Date(2027, 10, 1, 0, 0, 0, 0, time.UTC)
+	clusterKubeconfigTokenExpiry  = time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+)
+
+var clusterKubeconfigCA, clusterKubeconfigClientCert, clusterKubeconfigClientKey = clusterKubeconfigCertificates()
+
+func clusterKubeconfigCertificates() (ca, cert, key string) {
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "kubernetes"},
+		NotBefore:             clusterKubeconfigCAExpiry.AddDate(-10, 0, 0),
+		NotAfter:              clusterKubeconfigCAExpiry,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, _ := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+
+	clientKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	clientTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "kubernetes-admin", Organization: []string{"system:masters"}},
+		NotBefore:    clusterKubeconfigClientExpiry.AddDate(-1, 0, 0),
+		NotAfter:     clusterKubeconfigClientExpiry,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	clientDER, _ := x509.CreateCertificate(rand.Reader, clientTemplate, caTemplate, &clientKey.PublicKey, caKey)
+	keyDER, _ := x509.MarshalECPrivateKey(clientKey)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientDER})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func clusterKubeconfigToken() string {
+	enc := base64.RawURLEncoding.EncodeToString
+	return enc([]byte(`{"alg":"RS256"}`)) + "." +
+		enc([]byte(fmt.Sprintf(`{"sub":"palette-user","exp":%d}`, clusterKubeconfigTokenExpiry.Unix()))) + "." +
+		enc([]byte("signature"))
+}
+
+// clusterKubeconfig renders a kubeconfig with a single context for server,
+// whose user is given as YAML.
+func clusterKubeconfig(server, user string) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: app-cluster
+  cluster:
+    server: %s
+    certificate-authority-data: %s
+contexts:
+- name: app-cluster-ctx
+  context:
+    cluster: app-cluster
+    user: app-cluster-user
+current-context: app-cluster-ctx
+users:
+- name: app-cluster-user
+  user:
+%s`, server, base64.StdEncoding.EncodeToString([]byte(clusterKubeconfigCA)), user)
+}
+
+func clusterKubeconfigCertificateUser() string {
+	return fmt.Sprintf("    client-certificate-data: %s\n    client-key-data: %s\n",
+		base64.StdEncoding.EncodeToString([]byte(clusterKubeconfigClientCert)),
+		base64.StdEncoding.EncodeToString([]byte(clusterKubeconfigClientKey)))
+}
+
+func writeClusterKubeconfig(w http.ResponseWriter, kubeconfig string) {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(kubeconfig))
+}
+
+func clusterKubeconfigHandler(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("frp") == "false" {
+		writeClusterKubeconfig(w, clusterKubeconfig("https://10.10.0.10:6443", "    token: "+clusterKubeconfigToken()+"\n"))
+		return
+	}
+	writeClusterKubeconfig(w, clusterKubeconfig("https://app-cluster.proxy.example.com:443", clusterKubeconfigCertificateUser()))
+}
+
+func clusterAdminKubeconfigHandler(w http.ResponseWriter, _ *http.Request) {
+	writeClusterKubeconfig(w, clusterKubeconfig("https://10.10.0.10:6443", clusterKubeconfigCertificateUser()))
+}
+
+func clusterClientKubeconfigHandler(w http.ResponseWriter, _ *http.Request) {
+	writeClusterKubeconfig(w, clusterKubeconfig("https://10.10.0.10:6443", `    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: kubectl
+      args:
+      - oidc-login
+      - get-token
+      - --oidc-issuer-url=https://idp.example.com
+      - --oidc-client-id=palette
+      env:
+      - name: KUBECACHEDIR
+        value: /tmp/kube-cache
+`))
+}
+
+// ClusterKubeconfigRoutes serves the kubeconfigs of clusterKubeconfigUID.
+// Their paths are literal, so they must be registered before ClusterRoutes,
+// which serves the kubeconfigs of every other cluster.
+func ClusterKubeconfigRoutes() []Route {
+	return []Route{
+		{
+			Method:  "GET",
+			Path:    "/v1/spectroclusters/" + clusterKubeconfigUID + "/assets/kubeconfig",
+			Handler: clusterKubeconfigHandler,
+		},
+		{
+			Method:  "GET",
+			Path:    "/v1/spectroclusters/" + clusterKubeconfigUID + "/assets/adminKubeconfig",
+			Handler: clusterAdminKubeconfigHandler,
+		},
+		{
+			Method:  "GET",
+			Path:    "/v1/spectroclusters/" + clusterKubeconfigUID + "/assets/kubeconfigclient",
+			Handler: clusterClientKubeconfigHandler,
+		},
+	}
+}
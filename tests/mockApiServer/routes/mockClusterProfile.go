@@ -16,6 +16,22 @@ const (
 	// setReplaceWithProfileForExisting (cluster_common_profiles.go), which otherwise never
 	// errors against the always-200 static fixture.
 	clusterProfileGetErrorUID = "cluster-profile-get-error-uid"
+
+	// clusterProfileVariablesUID is the only profile that defines variables.
+	clusterProfileVariablesUID = "cluster-profile-variables-uid"
+
+	// clusterProfileDiffUID is version 1.1.0 of test-cluster-profile-1 for
+	// the spectrocloud_cluster_profile_diff tests. Compared with the cluster
+	// fixture under clusterProfileDiffClusterUID, k8 is upgraded, cni-calico
+	// is dropped, csi-aws-ebs is added and the manifest content changes.
+	clusterProfileDiffUID = "cluster-profile-diff-v2"
+
+	// clusterProfilePreviousUID is an older project version of
+	// test-cluster-profile-1, and clusterProfileTenantUID a newer one that
+	// is tenant scoped, so the spectrocloud_cluster_profile_version tests can
+	// tell the project lineage from another scope's profile of the same name.
+	clusterProfilePreviousUID = "cluster-profile-previous"
+	clusterProfileTenantUID   = "cluster-profile-tenant"
 )
 
 func getClusterProfilesMetadataResponse() *models.V1ClusterProfilesMetadata {
@@ -31,6 +47,26 @@ func getClusterProfilesMetadataResponse() *models.V1ClusterProfilesMetadata {
 					Version:   "1.0.0",
 				},
 			},
+			{
+				Metadata: &models.V1ObjectEntity{
+					Name: "test-cluster-profile-1",
+					UID:  clusterProfilePreviousUID,
+				},
+				Spec: &models.V1ClusterProfileMetadataSpec{
+					CloudType: "aws",
+					Version:   "0.9.0",
+				},
+			},
+			{
+				Metadata: &models.V1ObjectEntity{
+					Name: "test-cluster-profile-1",
+					UID:  clusterProfileTenantUID,
+				},
+				Spec: &models.V1ClusterProfileMetadataSpec{
+					CloudType: "aws",
+					Version:   "3.0.0",
+				},
+			},
 			{
 				Metadata: &models.V1ObjectEntity{
 					Name: "test-cluster-profile-2",
@@ -117,6 +153,30 @@ func clusterProfileGetHandler(w http.ResponseWriter, r *http.Request) {
 
 func clusterProfileFixtureFor(uid string) *models.V1ClusterProfile {
 	switch uid {
+	case clusterProfileDiffUID:
+		p := getClusterProfileResponse()
+		p.Metadata.UID = clusterProfileDiffUID
+		p.Spec.Version = "1.1.0"
+		p.Spec.Published.ProfileVersion = "1.1.0"
+		p.Spec.Published.Packs = []*models.V1PackRef{
+			{Name: strPtr("k8"), Tag: "1.28.0", Values: "replicas: 1\nimage: k8s:1.28\n"},
+			{Name: strPtr("csi-aws-ebs"), Tag: "1.20.0", Values: "storageClass: gp3"},
+			{Name: strPtr("custom-manifests"), Type: "manifest", Manifests: []*models.V1ObjectReference{{Name: "test-manifest-1"}}},
+		}
+		return p
+	case clusterProfilePreviousUID:
+		p := getClusterProfileResponse()
+		p.Metadata.UID = clusterProfilePreviousUID
+		p.Spec.Version = "0.9.0"
+		p.Spec.Published.ProfileVersion = "0.9.0"
+		return p
+	case clusterProfileTenantUID:
+		p := getClusterProfileResponse()
+		p.Metadata.Annotations = map[string]string{"scope": "tenant"}
+		p.Metadata.UID = clusterProfileTenantUID
+		p.Spec.Version = "3.0.0"
+		p.Spec.Published.ProfileVersion = "3.0.0"
+		return p
 	case clusterProfileUID2:
 		p := getClusterProfileResponse()
 		p.Metadata.Name = "test-cluster-profile-2"
@@ -168,12 +228,9 @@ func ClusterProfileRoutes() []Route {
 			},
 		},
 		{
-			Method: "GET",
-			Path:   "/v1/clusterprofiles/{uid}/variables",
-			Response: ResponseData{
-				StatusCode: 200,
-				Payload:    &models.V1Variables{},
-			},
+			Method:  "GET",
+			Path:    "/v1/clusterprofiles/{uid}/variables",
+			Handler: clusterProfileVariablesHandler,
 		},
 		{
 			Method: "PATCH",
@@ -253,16 +310,61 @@ func ClusterProfileRoutes() []Route {
 			Handler: clusterProfileGetHandler,
 		},
 		{
-			Method: "GET",
-			Path:   "/v1/clusterprofiles/{uid}/packs/{packName}/manifests",
-			Response: ResponseData{
-				StatusCode: 200,
-				Payload:    getClusterProfilePackManifestResponse(),
-			},
+			Method:  "GET",
+			Path:    "/v1/clusterprofiles/{uid}/packs/{packName}/manifests",
+			Handler: clusterProfilePackManifestHandler,
+		},
+		{
+			Method:  "GET",
+			Path:    "/v1/clusterprofiles/{uid}/export",
+			Handler: clusterProfileExportHandler,
 		},
 	}
 }
 
+// clusterProfileVariablesHandler serves variable definitions for
+// clusterProfileVariablesUID and none for any other profile.
+func clusterProfileVariablesHandler(w http.ResponseWriter, r *http.Request) {
+	variables := &models.V1Variables{}
+	if mux.Vars(r)["uid"] == clusterProfileVariablesUID {
+		format := func(f models.V1VariableFormat) *models.V1VariableFormat { return &f }
+		variables.Variables = []*models.V1Variable{
+			{Name: strPtr("replicas"), Format: format(models.V1VariableFormatNumber), Required: true, DefaultValue: "1"},
+			{Name: strPtr("pod_cidr"), Format: format(models.V1VariableFormatIpv4cidr), Required: true},
+			{Name: strPtr("env"), Format: format(models.V1VariableFormatString), Regex: "^(dev|prod)$", DefaultValue: "dev"},
+			{Name: strPtr("token"), Format: format(models.V1VariableFormatBase64), IsSensitive: true},
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(variables)
+}
+
+// clusterProfileExportHandler serves the profile export, which Palette sends
+// as a file download rather than a JSON response.
+func clusterProfileExportHandler(w http.ResponseWriter, r *http.Request) {
+	content := `{"metadata":{"name":"test-cluster-profile-1"},"spec":{"version":"1.0.0","template":{"type":"cluster","packs":[{"name":"k8","values":"{test-json:test}"}]},"variables":[{"name":"replicas","defaultValue":"1"}]}}`
+	if r.URL.Query().Get("format") == "yaml" {
+		content = "metadata:\n  name: test-cluster-profile-1\nspec:\n  version: 1.0.0\n"
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", "attachment; filename=test-cluster-profile-1.json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(content))
+}
+
+// clusterProfilePackManifestHandler serves the static manifest fixture, with
+// different content for clusterProfileDiffUID.
+func clusterProfilePackManifestHandler(w http.ResponseWriter, r *http.Request) {
+	manifests := getClusterProfilePackManifestResponse()
+	if mux.Vars(r)["uid"] == clusterProfileDiffUID {
+		manifests.Items[0].Spec.Published.Content = "kind: ConfigMap\nmetadata:\n  name: test-manifest-1"
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(manifests)
+}
+
 func ClusterProfileNegativeRoutes() []Route {
 	return []Route{
 		{
@@ -0,0 +1,192 @@
+package routes
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
+)
+
+// Compliance scan logs, used by the spectrocloud_cluster_scan_results and
+// spectrocloud_cluster_scan tests. Each scan cluster has two configuration
+// scans, one penetration scan and one conformance scan. On-demand scans of a
+// scan cluster are added to its logs, completed, or failed for
+// clusterScanFailedUID. Other clusters have no scans.
+const (
+	clusterScanUID         = "cluster-uid-scan"
+	clusterScanOnDemandUID = "cluster-uid-scan-on-demand"
+	clusterScanFailedUID   = "cluster-uid-scan-failed"
+)
+
+var clusterScanTime = time.Date(2026, 10, 1, 2, 0, 0, 0, time.UTC)
+
+var clusterScansOnDemand = struct {
+	sync.Mutex
+	count int
+	logs  map[string]*models.V1ClusterComplianceScanLogs
+}{logs: map[string]*models.V1ClusterComplianceScanLogs{}}
+
+func clusterScanLogTime(start time.Time) *models.V1ClusterScanTime {
+	return &models.V1ClusterScanTime{StartTime: models.V1Time(start), EndTime: models.V1Time(start.Add(10 * time.Minute))}
+}
+
+func kubeBenchScanLog(requestUID, state string, start time.Time, failed ...string) *models.V1ClusterScanLogKubeBench {
+	logs := []*models.V1KubeBenchLog{{TestID: "1.1.1", State: "PASS"}, {TestID: "1.2.1", State: "WARN"}}
+	for _, id := range failed {
+		logs = append(logs, &models.V1KubeBenchLog{TestID: id, State: "FAIL"})
+	}
+	return &models.V1ClusterScanLogKubeBench{
+		Metadata: &models.V1ObjectMeta{UID: requestUID + "-log"},
+		Status: &models.V1ClusterKubeBenchLogStatus{
+			RequestUID: requestUID,
+			State:      state,
+			ScanTime:   clusterScanLogTime(start),
+			Reports: map[string]models.V1KubeBenchReport{
+				"master": {Pass: 40, Warn: 5, Fail: int32(len(failed)), Logs: logs},
+				"node":   {Pass: 20, Warn: 2},
+			},
+		},
+	}
+}
+
+func clusterScanLogsFixture() *models.V1ClusterComplianceScanLogs {
+	return &models.V1ClusterComplianceScanLogs{
+		KubeBenchLogs: []*models.V1ClusterScanLogKubeBench{
+			kubeBenchScanLog("kube-bench-request-new", "Completed", clusterScanTime, "1.2.16", "1.1.12"),
+			kubeBenchScanLog("kube-bench-request-old", "Completed", clusterScanTime.Add(-24*time.Hour), "1.2.16", "1.1.12", "4.2.6"),
+		},
+		KubeHunterLogs: []*models.V1ClusterScanLogKubeHunter{
+			{
+				Metadata: &models.V1ObjectMeta{UID: "kube-hunter-request-log"},
+				Status: &models.V1ClusterKubeHunterLogStatus{
+					RequestUID: "kube-hunter-request",
+					State:      "Completed",
+					ScanTime:   clusterScanLogTime(clusterScanTime),
+					Reports: map[string]models.V1KubeHunterReport{
+						"report": {
+							Vulnerabilites: &models.V1KubeHunterVulnerabilities{Medium: 1, Low: 1},
+							Logs: []*models.V1KubeHunterLog{
+								{TestID: "KHV002", Severity: "medium", Vulnerability: "K8s Version Disclosure"},
+								{TestID: "KHV005", Severity: "low", Vulnerability: "Access to server API"},
+							},
+						},
+					},
+				},
+			},
+		},
+		SonobuoyLogs: []*models.V1ClusterScanLogSonobuoy{
+			{
+				// A conformance scan that is still running: it has not finished.
+				Metadata: &models.V1ObjectMeta{
+					UID:               "sonobuoy-request-log",
+					CreationTimestamp: models.V1Time(clusterScanTime),
+				},
+				Status: &models.V1ClusterSonobuoyLogStatus{
+					RequestUID: "sonobuoy-request",
+					State:      "InProgress",
+					Reports: map[string]models.V1SonobuoyReport{
+						"e2e": {
+							Pass:  310,
+							Fail:  1,
+							Total: 311,
+							Logs: []*models.V1SonobuoyLog{
+								{Description: "[sig-network] DNS should provide DNS for services", State: "failed"},
+								{Description: "[sig-node] Pods should be submitted and removed", State: "passed"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func clusterScanLogsHandler(w http.ResponseWriter, r *http.Request) {
+	uid := mux.Vars(r)["uid"]
+	w.Header().Set("Content-Type", "application/json")
+	switch uid {
+	case clusterPolicyConfigErrorUID:
+		w.WriteHeader(http.StatusInternalServerError)
+		_ = json.NewEncoder(w).Encode(getError("500", "failed to get cluster scan logs"))
+		return
+	case clusterScanUID, clusterScanOnDemandUID, clusterScanFailedUID:
+	default:
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&models.V1ClusterComplianceScanLogs{})
+		return
+	}
+
+	logs := clusterScanLogsFixture()
+	clusterScansOnDemand.Lock()
+	if onDemand, ok := clusterScansOnDemand.logs[uid]; ok {
+		logs.KubeBenchLogs = append(logs.KubeBenchLogs, onDemand.KubeBenchLogs...)
+		logs.KubeHunterLogs = append(logs.KubeHunterLogs, onDemand.KubeHunterLogs...)
+		logs.SonobuoyLogs = append(logs.SonobuoyLogs, onDemand.SonobuoyLogs...)
+	}
+	clusterScansOnDemand.Unlock()
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(logs)
+}
+
+// clusterScanOnDemandHandler adds a scan of each requested driver to the logs
+// of the cluster. The request UIDs are new on every call, so a cluster can be
+// scanned any number of times.
+func clusterScanOnDemandHandler(w http.ResponseWriter, r *http.Request) {
+	uid := mux.Vars(r)["uid"]
+	var config models.V1ClusterComplianceOnDemandConfig
+	_ = json.NewDecoder(r.Body).Decode(&config)
+
+	clusterScansOnDemand.Lock()
+	defer clusterScansOnDemand.Unlock()
+	clusterScansOnDemand.count++
+	requestUID := fmt.Sprintf("scan-request-%d", clusterScansOnDemand.count)
+	start := clusterScanTime.Add(time.Duration(clusterScansOnDemand.count) * time.Hour)
+	state, message := "Completed", ""
+	if uid == clusterScanFailedUID {
+		state, message = "Failed", "kube-bench job failed"
+	}
+
+	logs, ok := clusterScansOnDemand.logs[uid]
+	if !ok {
+		logs = &models.V1ClusterComplianceScanLogs{}
+		clusterScansOnDemand.logs[uid] = logs
+	}
+	if config.KubeBench != nil && config.KubeBench.RunScan {
+		l := kubeBenchScanLog(requestUID, state, start, "1.2.16")
+		l.Status.Message = message
+		logs.KubeBenchLogs = append(logs.KubeBenchLogs, l)
+	}
+	if config.KubeHunter != nil && config.KubeHunter.RunScan {
+		logs.KubeHunterLogs = append(logs.KubeHunterLogs, &models.V1ClusterScanLogKubeHunter{
+			Status: &models.V1ClusterKubeHunterLogStatus{RequestUID: requestUID, State: "Completed", ScanTime: clusterScanLogTime(start)},
+		})
+	}
+	if config.Sonobuoy != nil && config.Sonobuoy.RunScan {
+		logs.SonobuoyLogs = append(logs.SonobuoyLogs, &models.V1ClusterScanLogSonobuoy{
+			Status: &models.V1ClusterSonobuoyLogStatus{RequestUID: requestUID, State: "Completed", ScanTime: clusterScanLogTime(start)},
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(&models.V1UID{UID: strPtr(requestUID)})
+}
+
+func ClusterScanRoutes() []Route {
+	return []Route{
+		{
+			Method:  "GET",
+			Path:    "/v1/spectroclusters/{uid}/features/complianceScan/logs/drivers",
+			Handler: clusterScanLogsHandler,
+		},
+		{
+			Method:  "POST",
+			Path:    "/v1/spectroclusters/{uid}/features/complianceScan/onDemand",
+			Handler: clusterScanOnDemandHandler,
+		},
+	}
+}
@@ -1,8 +1,11 @@
 package routes
 
 import (
-	"github.com/spectrocloud/palette-sdk-go/api/models"
+	"encoding/json"
 	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/spectrocloud/palette-sdk-go/api/models"
 )
 
 func getPackSummaryPayload() *models.V1PackSummaries {
@@ -158,8 +161,52 @@ func getPacksNameRegistryUID() *models.V1PackTagEntity {
 	}
 }
 
+// packDefaultValues are the registry defaults served for every pack UID.
+const packDefaultValues = `replicaCount: 1
+image:
+  repository: nginx
+  # pinned by the chart
+  tag: "1.25"
+service:
+  type: ClusterIP
+  port: 80
+tolerations:
+  - key: dedicated
+    operator: Exists
+`
+
+// packGetHandler serves a pack whose values belong to the requested UID.
+func packGetHandler(w http.ResponseWriter, r *http.Request) {
+	uid := mux.Vars(r)["uid"]
+	pack := &models.V1PackTagEntity{
+		AddonType:  "infra",
+		CloudTypes: []string{"all"},
+		Name:       "k8",
+		PackValues: []*models.V1PackUIDValues{
+			{
+				PackUID: uid,
+				Values:  packDefaultValues,
+				Schema: []*models.V1PackSchema{
+					{Name: "replicaCount", Type: "number"},
+					{Name: "image.tag", Type: "string", Regex: `^[0-9.]+$`},
+					{Name: "service.type", Type: "string", ListOptions: []string{"ClusterIP", "NodePort", "LoadBalancer"}},
+				},
+			},
+		},
+		RegistryUID: "test-reg-uid",
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(pack)
+}
+
 func PacksRoutes() []Route {
 	return []Route{
+		{
+			Method:  "GET",
+			Path:    "/v1/packs/{uid}",
+			Handler: packGetHandler,
+		},
 		{
 			Method: "GET",
 			Path:   "/v1/packs",
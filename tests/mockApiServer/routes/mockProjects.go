@@ -8,6 +8,10 @@ import (
 
 const defaultProjectUID = "testprojectuid"
 
+// devProjectUID is a second project, for resources that set their own
+// project argument instead of using the provider's project_name.
+const devProjectUID = "devprojectuid"
+
 func getMockProjectPayload() models.V1Project {
 	return models.V1Project{
 		Metadata: &models.V1ObjectMeta{
@@ -65,6 +69,12 @@ func ProjectRoutes() []Route {
 								UID:  defaultProjectUID,
 							},
 						},
+						{
+							Metadata: &models.V1ObjectEntity{
+								Name: "Dev",
+								UID:  devProjectUID,
+							},
+						},
 					},
 				},
 			},
@@ -98,6 +108,12 @@ func ProjectRoutes() []Route {
 								UID:  defaultProjectUID,
 							},
 						},
+						{
+							Metadata: &models.V1ObjectEntity{
+								Name: "Dev",
+								UID:  devProjectUID,
+							},
+						},
 					},
 				},
 			},